@@ -3,6 +3,7 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 func (s *AccessTokensService) ListRepositoryTokens(ctx context.Context, projectKey, repositorySlug string, opts *ListOptions) ([]*AccessToken, *Response, error) {
@@ -15,6 +16,13 @@ func (s *AccessTokensService) ListRepositoryTokens(ctx context.Context, projectK
 	return list.Tokens, resp, nil
 }
 
+func (s *AccessTokensService) ListRepositoryTokensIter(ctx context.Context, projectKey, repositorySlug string, opts *ListOptions) iter.Seq2[*AccessToken, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, o, func(ctx context.Context) ([]*AccessToken, *Response, error) {
+		return s.ListRepositoryTokens(ctx, projectKey, repositorySlug, o)
+	})
+}
+
 func (s *AccessTokensService) GetRepositoryToken(ctx context.Context, projectKey, repositorySlug, tokenId string) (*AccessToken, *Response, error) {
 	p := fmt.Sprintf("projects/%s/repos/%s/%s", projectKey, repositorySlug, tokenId)
 	var token AccessToken
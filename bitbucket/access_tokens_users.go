@@ -3,6 +3,7 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 func (s *AccessTokensService) ListUserTokens(ctx context.Context, userSlug string, opts *ListOptions) ([]*AccessToken, *Response, error) {
@@ -15,6 +16,13 @@ func (s *AccessTokensService) ListUserTokens(ctx context.Context, userSlug strin
 	return list.Tokens, resp, nil
 }
 
+func (s *AccessTokensService) ListUserTokensIter(ctx context.Context, userSlug string, opts *ListOptions) iter.Seq2[*AccessToken, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, o, func(ctx context.Context) ([]*AccessToken, *Response, error) {
+		return s.ListUserTokens(ctx, userSlug, o)
+	})
+}
+
 func (s *AccessTokensService) GetUserToken(ctx context.Context, userSlug, tokenId string) (*AccessToken, *Response, error) {
 	p := fmt.Sprintf("users/%s/%s", userSlug, tokenId)
 	var token AccessToken
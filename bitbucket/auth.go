@@ -0,0 +1,87 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// TokenSource supplies the token used for bearer authentication. The token is requested for every
+// request allowing it to be rotated or refreshed without recreating the client.
+type TokenSource interface {
+	Token() (string, error)
+}
+
+// TokenSourceFunc adapts a function to the TokenSource interface.
+type TokenSourceFunc func() (string, error)
+
+func (f TokenSourceFunc) Token() (string, error) {
+	return f()
+}
+
+// StaticTokenSource is a TokenSource returning a token which can be replaced at any time, e.g.
+// when a personal or repository access token is rotated.
+type StaticTokenSource struct {
+	mu    sync.RWMutex
+	token string
+}
+
+// NewStaticTokenSource returns a StaticTokenSource returning the given token.
+func NewStaticTokenSource(token string) *StaticTokenSource {
+	return &StaticTokenSource{token: token}
+}
+
+func (s *StaticTokenSource) Token() (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.token, nil
+}
+
+// SetToken replaces the token returned for subsequent requests.
+func (s *StaticTokenSource) SetToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = token
+}
+
+// BearerTokenTransport supports creating a http client passing an HTTP access token as bearer
+// authentication header.
+type BearerTokenTransport struct {
+	// Token is the access token used if no Source is set.
+	Token string
+
+	// Source supplies the token for every request, takes precedence over Token.
+	Source TokenSource
+
+	// Transport is the underlying RoundTripper used to make requests, http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *BearerTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token := t.Token
+	if t.Source != nil {
+		var err error
+		token, err = t.Source.Token()
+		if err != nil {
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, fmt.Errorf("unable to get access token: %w", err)
+		}
+	}
+	req2 := setBearerTokenAsHeader(req, token)
+	return transport(t.Transport).RoundTrip(req2)
+}
+
+func setBearerTokenAsHeader(req *http.Request, token string) *http.Request {
+	convertedRequest := cloneRequestHeaders(req)
+	convertedRequest.Header.Set("Authorization", "Bearer "+token)
+	return convertedRequest
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using HTTP bearer token authentication.
+func (t *BearerTokenTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
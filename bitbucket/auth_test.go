@@ -0,0 +1,91 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBearerTokenTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "Bearer BBDC-xxxx", req.Header.Get("Authorization"))
+		rw.Write([]byte(getProjectsRepositoryResponse))
+	}))
+	defer server.Close()
+
+	hc := (&BearerTokenTransport{Token: "BBDC-xxxx"}).Client()
+	client, _ := NewClient(server.URL, hc)
+	ctx := context.Background()
+	_, _, err := client.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.NoError(t, err)
+}
+
+func TestBearerTokenTransportRotation(t *testing.T) {
+	tokens := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		tokens = append(tokens, req.Header.Get("Authorization"))
+		rw.Write([]byte(getProjectsRepositoryResponse))
+	}))
+	defer server.Close()
+
+	src := NewStaticTokenSource("first")
+	hc := (&BearerTokenTransport{Token: "ignored", Source: src}).Client()
+	client, _ := NewClient(server.URL, hc)
+	ctx := context.Background()
+	_, _, err := client.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.NoError(t, err)
+	src.SetToken("second")
+	_, _, err = client.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Bearer first", "Bearer second"}, tokens)
+}
+
+func TestBearerTokenTransportSourceError(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+
+	src := TokenSourceFunc(func() (string, error) {
+		return "", fmt.Errorf("token expired")
+	})
+	hc := (&BearerTokenTransport{Source: src}).Client()
+	client, _ := NewClient(server.URL, hc)
+	ctx := context.Background()
+	_, _, err := client.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.ErrorContains(t, err, "token expired")
+	assert.Equal(t, 0, calls)
+}
+
+type recordingTransport struct {
+	requests []*http.Request
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestBasicAuthTransportUnderlying(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		u, p, ok := req.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", u)
+		assert.Equal(t, "secret", p)
+		rw.Write([]byte(getProjectsRepositoryResponse))
+	}))
+	defer server.Close()
+
+	rt := &recordingTransport{}
+	hc := (&BasicAuthTransport{Username: "user", Password: "secret", Transport: rt}).Client()
+	client, _ := NewClient(server.URL, hc)
+	ctx := context.Background()
+	_, _, err := client.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.NoError(t, err)
+	assert.Len(t, rt.requests, 1)
+}
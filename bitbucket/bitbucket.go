@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -29,12 +30,22 @@ type Client struct {
 	ApiVersion string
 	UserAgent  string
 
+	// RetryPolicy enables retrying requests failing with transient errors when set.
+	RetryPolicy *RetryPolicy
+
+	// RateLimiter limits the rate of requests sent by the client when set.
+	RateLimiter RateLimiter
+
+	rateMu sync.Mutex
+	rate   RateLimit
+
 	common service
 
-	AccessTokens *AccessTokensService
-	Keys         *KeysService
-	Projects     *ProjectsService
-	Users        *UsersService
+	AccessTokens      *AccessTokensService
+	BranchPermissions *BranchPermissionsService
+	Keys              *KeysService
+	Projects          *ProjectsService
+	Users             *UsersService
 }
 
 type service struct {
@@ -128,6 +139,11 @@ func (t ISOTime) MarshalJSON() ([]byte, error) {
 	return []byte(s), nil
 }
 
+// Ptr returns a pointer to the value, e.g. for setting optional fields of RepositoryUpdate.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
 type Permission string
 
 const (
@@ -149,22 +165,46 @@ const (
 type Response struct {
 	*http.Response
 	*Page
+
+	// Rate is the rate limit state reported in the response headers.
+	Rate RateLimit
 }
 
 type ErrorResponse struct {
 	*http.Response
 	Errors []ErrorMessage
+
+	// Body holds the (possibly truncated) raw response body, e.g. when a proxy returns
+	// an HTML or plain text error page which cannot be parsed as Bitbucket errors.
+	Body []byte `json:"-"`
 }
 
 type ErrorMessage struct {
+	Context   string `json:"context,omitempty"`
 	Message   string `json:"message,omitempty"`
 	Exception string `json:"exceptionName,omitempty"`
 }
 
+// maxErrorSnippetSize limits the part of a body without Bitbucket errors included in the error message.
+const maxErrorSnippetSize = 200
+
 func (e *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: %d %+v",
+	msg := fmt.Sprintf("%v %v: %d %+v",
 		e.Response.Request.Method, e.Response.Request.URL,
 		e.Response.StatusCode, e.Errors)
+	if len(e.Errors) == 0 && len(e.Body) > 0 {
+		msg += ": " + bodySnippet(e.Body)
+	}
+	return msg
+}
+
+// bodySnippet returns the start of the body on a single line, truncated to maxErrorSnippetSize bytes.
+func bodySnippet(body []byte) string {
+	s := strings.Join(strings.Fields(string(body)), " ")
+	if len(s) <= maxErrorSnippetSize {
+		return s
+	}
+	return strings.ToValidUTF8(s[:maxErrorSnippetSize], "") + "..."
 }
 
 // NewClient returns new Bitbucket client for accessing Bitbucket APIs
@@ -186,6 +226,7 @@ func NewClient(baseURL string, httpClient *http.Client) (*Client, error) {
 	c.BaseURL = baseEndpoint
 	c.common.client = c
 	c.AccessTokens = (*AccessTokensService)(&c.common)
+	c.BranchPermissions = (*BranchPermissionsService)(&c.common)
 	c.Keys = (*KeysService)(&c.common)
 	c.Projects = (*ProjectsService)(&c.common)
 	c.Users = (*UsersService)(&c.common)
@@ -228,14 +269,11 @@ func (c *Client) NewRequest(method, apiName, path string, body interface{}) (*ht
 }
 
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	r, err := c.client.Do(req.WithContext(ctx))
-	if err != nil {
+	resp, err := c.stream(ctx, req)
+	if resp == nil {
 		return nil, err
 	}
-	resp := &Response{Response: r}
 	defer resp.Body.Close()
-
-	err = CheckResponse(r)
 	if err != nil {
 		return resp, err
 	}
@@ -258,12 +296,57 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return resp, nil
 }
 
+// stream sends the request and checks the response leaving the body open for the caller to read and close.
+func (c *Client) stream(ctx context.Context, req *http.Request) (*Response, error) {
+	r, err := c.send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &Response{Response: r, Rate: parseRateLimit(r)}
+	c.setRateLimit(resp.Rate)
+
+	err = CheckResponse(r)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+const maxErrorBodySize = 1024 * 1024 // 1 MiB
+
+// CheckResponse checks the API response for errors. A response with a status code outside
+// the 2xx range is returned as *ErrorResponse with the Bitbucket error messages decoded
+// from the body when present, or as *RateLimitError wrapping the *ErrorResponse for 429 Too Many
+// Requests.
 func CheckResponse(r *http.Response) error {
 	if c := r.StatusCode; 200 <= c && c <= 299 {
 		return nil
 	}
 
-	return &ErrorResponse{Response: r}
+	errorResponse := &ErrorResponse{Response: r}
+	if r.Body == nil {
+		return errorResponse
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxErrorBodySize))
+	if err == nil && len(data) > 0 {
+		errorResponse.Body = data
+		// Decoding errors are ignored as the body may be a non-JSON error page from a proxy.
+		var body struct {
+			Errors []ErrorMessage `json:"errors"`
+		}
+		if json.Unmarshal(data, &body) == nil {
+			errorResponse.Errors = body.Errors
+		}
+	}
+	// Close the original body and allow the buffered copy to be read again by callers inspecting the response.
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(data))
+
+	if r.StatusCode == http.StatusTooManyRequests {
+		return &RateLimitError{ErrorResponse: errorResponse, Rate: parseRateLimit(r)}
+	}
+	return errorResponse
 }
 
 func (c *Client) Get(ctx context.Context, api, path string, v interface{}) (*Response, error) {
@@ -275,29 +358,48 @@ func (c *Client) GetPaged(ctx context.Context, api, path string, v interface{},
 	if err != nil {
 		return nil, err
 	}
-	if opts != nil {
-		query, err := query.Values(opts)
-		if err != nil {
-			return nil, err
-		}
-		req.URL.RawQuery = query.Encode()
+	err = addOptions(req, opts)
+	if err != nil {
+		return nil, err
 	}
 	return c.Do(ctx, req, v)
 }
 
+// addOptions encodes the options as query parameters of the request.
+func addOptions(req *http.Request, opts interface{}) error {
+	if opts == nil {
+		return nil
+	}
+	query, err := query.Values(opts)
+	if err != nil {
+		return err
+	}
+	req.URL.RawQuery = query.Encode()
+	return nil
+}
+
 // BasicAuthTransport supports creating a http client passing username/password as basic authentication header.
 type BasicAuthTransport struct {
 	Username string
 	Password string
+
+	// Transport is the underlying RoundTripper used to make requests, http.DefaultTransport if nil.
+	Transport http.RoundTripper
 }
 
 // RoundTrip implements the RoundTripper interface.
 func (t *BasicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req2 := setCredentialsAsHeaders(req, t.Username, t.Password)
-	return http.DefaultTransport.RoundTrip(req2)
+	return transport(t.Transport).RoundTrip(req2)
 }
 
 func setCredentialsAsHeaders(req *http.Request, id, secret string) *http.Request {
+	convertedRequest := cloneRequestHeaders(req)
+	convertedRequest.SetBasicAuth(id, secret)
+	return convertedRequest
+}
+
+func cloneRequestHeaders(req *http.Request) *http.Request {
 	// To set extra headers, we must make a copy of the Request so
 	// that we don't modify the Request we were given. This is required by the
 	// specification of http.RoundTripper.
@@ -310,10 +412,16 @@ func setCredentialsAsHeaders(req *http.Request, id, secret string) *http.Request
 	for k, s := range req.Header {
 		convertedRequest.Header[k] = append([]string(nil), s...)
 	}
-	convertedRequest.SetBasicAuth(id, secret)
 	return convertedRequest
 }
 
+func transport(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		return http.DefaultTransport
+	}
+	return rt
+}
+
 // Client returns an *http.Client that makes requests that are authenticated
 // using HTTP Basic Authentication.
 func (t *BasicAuthTransport) Client() *http.Client {
@@ -1,7 +1,13 @@
 package bitbucket
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -22,3 +28,129 @@ func TestDateTimeMarshall(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, []byte(fmt.Sprintf("%d", ts.Unix()*1000)), b)
 }
+
+func TestCheckResponseErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusConflict)
+		rw.Write([]byte(repositoryExistsErrorResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.CreateRepository(ctx, "PRJ", &Repository{Name: "repo", ScmID: "git"})
+	assert.Error(t, err)
+	var er *ErrorResponse
+	assert.True(t, errors.As(err, &er))
+	assert.Equal(t, http.StatusConflict, er.StatusCode)
+	assert.Len(t, er.Errors, 1)
+	assert.Equal(t, "name", er.Errors[0].Context)
+	assert.Equal(t, "This repository URL is already taken by 'repo' in 'Project'", er.Errors[0].Message)
+	assert.Equal(t, "com.atlassian.bitbucket.repository.RepositoryExistsException", er.Errors[0].Exception)
+	assert.Contains(t, err.Error(), "RepositoryExistsException")
+}
+
+func TestCheckResponseNonJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "text/html")
+		rw.WriteHeader(http.StatusBadGateway)
+		rw.Write([]byte("<html><body>502 Bad Gateway</body></html>"))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.Error(t, err)
+	var er *ErrorResponse
+	assert.True(t, errors.As(err, &er))
+	assert.Equal(t, http.StatusBadGateway, er.StatusCode)
+	assert.Empty(t, er.Errors)
+	assert.Equal(t, "<html><body>502 Bad Gateway</body></html>", string(er.Body))
+	assert.True(t, strings.HasSuffix(err.Error(), ": 502 []: <html><body>502 Bad Gateway</body></html>"))
+}
+
+func TestErrorResponseBodySnippet(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://bitbucket.example/rest/api/latest/projects", nil)
+	er := &ErrorResponse{
+		Response: &http.Response{Request: req, StatusCode: http.StatusBadGateway},
+		Body:     []byte("<html>\n  <body>" + strings.Repeat("x", 300) + "</body>\n</html>"),
+	}
+	msg := er.Error()
+	assert.True(t, strings.HasPrefix(msg, "GET http://bitbucket.example/rest/api/latest/projects: 502 []: <html> <body>xxx"))
+	assert.True(t, strings.HasSuffix(msg, "x..."))
+	assert.NotContains(t, msg, "\n")
+
+	er.Errors = []ErrorMessage{{Message: "Bad gateway"}}
+	assert.Equal(t, "GET http://bitbucket.example/rest/api/latest/projects: 502 [{Context: Message:Bad gateway Exception:}]", er.Error())
+}
+
+func TestCheckResponseBodyReadable(t *testing.T) {
+	r := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader(`{"errors":[{"message":"Repository PRJ/repo does not exist."}]}`)),
+	}
+	err := CheckResponse(r)
+	assert.Error(t, err)
+	er, _ := err.(*ErrorResponse)
+	assert.Equal(t, "Repository PRJ/repo does not exist.", er.Errors[0].Message)
+	b, _ := io.ReadAll(r.Body)
+	assert.Equal(t, er.Body, b)
+}
+
+type closeRecorder struct {
+	io.ReadCloser
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return c.ReadCloser.Close()
+}
+
+// bodyRecorderTransport records the bodies of the responses to check they are closed.
+type bodyRecorderTransport struct {
+	bodies []*closeRecorder
+}
+
+func (t *bodyRecorderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body := &closeRecorder{ReadCloser: r.Body}
+	t.bodies = append(t.bodies, body)
+	r.Body = body
+	return r, nil
+}
+
+func TestErrorResponseBodyClosed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusNotFound)
+		rw.Write([]byte(`{"errors":[{"message":"Not found"}]}`))
+	}))
+	defer server.Close()
+
+	rt := &bodyRecorderTransport{}
+	client, _ := NewClient(server.URL, &http.Client{Transport: rt})
+	ctx := context.Background()
+	_, _, err := client.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.True(t, IsNotFound(err))
+	_, _, err = client.Projects.GetRawFile(ctx, "PRJ", "repo", "README.md", "")
+	assert.True(t, IsNotFound(err))
+
+	assert.Len(t, rt.bodies, 2)
+	for _, b := range rt.bodies {
+		assert.True(t, b.closed)
+	}
+}
+
+const repositoryExistsErrorResponse = `{
+  "errors": [
+    {
+      "context": "name",
+      "message": "This repository URL is already taken by 'repo' in 'Project'",
+      "exceptionName": "com.atlassian.bitbucket.repository.RepositoryExistsException"
+    }
+  ]
+}`
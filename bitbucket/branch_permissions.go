@@ -0,0 +1,235 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+type BranchPermissionsService service
+
+const branchPermissionsApiName = "branch-permissions"
+
+type BranchRestrictionList struct {
+	ListResponse
+	Restrictions []InternalBranchRestriction `json:"values"`
+}
+
+type InternalBranchRestriction struct {
+	ID         uint64                `json:"id,omitempty"`
+	Type       BranchRestrictionType `json:"type"`
+	Matcher    InternalBranchMatcher `json:"matcher"`
+	Users      []User                `json:"users,omitempty"`
+	Groups     []string              `json:"groups,omitempty"`
+	AccessKeys []InternalSshKey      `json:"accessKeys,omitempty"`
+}
+
+type InternalBranchMatcher struct {
+	ID        string `json:"id"`
+	DisplayID string `json:"displayId,omitempty"`
+	Type      struct {
+		ID   BranchMatcherType `json:"id"`
+		Name string            `json:"name,omitempty"`
+	} `json:"type"`
+	Active bool `json:"active"`
+}
+
+type branchRestrictionRequest struct {
+	ID         uint64                `json:"id,omitempty"`
+	Type       BranchRestrictionType `json:"type"`
+	Matcher    InternalBranchMatcher `json:"matcher"`
+	Users      []string              `json:"users,omitempty"`
+	Groups     []string              `json:"groups,omitempty"`
+	AccessKeys []uint64              `json:"accessKeys,omitempty"`
+}
+
+// BranchRestriction defines Bitbucket representation of a branch permission
+type BranchRestriction struct {
+	ID      uint64
+	Type    BranchRestrictionType
+	Matcher BranchMatcher
+
+	// Users, Groups and AccessKeys are exempt from the restriction. Users are identified by name
+	// and access keys by the ID of the ssh key.
+	Users      []string
+	Groups     []string
+	AccessKeys []uint64
+}
+
+type BranchRestrictionType string
+
+const (
+	BranchRestrictionTypeReadOnly        BranchRestrictionType = "read-only"
+	BranchRestrictionTypeNoDeletes       BranchRestrictionType = "no-deletes"
+	BranchRestrictionTypeFastForwardOnly BranchRestrictionType = "fast-forward-only"
+	BranchRestrictionTypePullRequestOnly BranchRestrictionType = "pull-request-only"
+)
+
+// BranchMatcher selects the refs a restriction applies to.
+type BranchMatcher struct {
+	ID        string
+	DisplayID string
+	Type      BranchMatcherType
+	Active    bool
+}
+
+type BranchMatcherType string
+
+const (
+	BranchMatcherTypeBranch        BranchMatcherType = "BRANCH"
+	BranchMatcherTypePattern       BranchMatcherType = "PATTERN"
+	BranchMatcherTypeModelCategory BranchMatcherType = "MODEL_CATEGORY"
+	BranchMatcherTypeModelBranch   BranchMatcherType = "MODEL_BRANCH"
+	BranchMatcherTypeAnyRef        BranchMatcherType = "ANY_REF"
+)
+
+const anyRefMatcherID = "ANY_REF_MATCHER_ID"
+
+// BranchModelCategory is a category of branches defined by the branching model.
+type BranchModelCategory string
+
+const (
+	BranchModelCategoryFeature BranchModelCategory = "FEATURE"
+	BranchModelCategoryBugfix  BranchModelCategory = "BUGFIX"
+	BranchModelCategoryHotfix  BranchModelCategory = "HOTFIX"
+	BranchModelCategoryRelease BranchModelCategory = "RELEASE"
+)
+
+// BranchModelBranch identifies the development or production branch of the branching model.
+type BranchModelBranch string
+
+const (
+	BranchModelBranchDevelopment BranchModelBranch = "development"
+	BranchModelBranchProduction  BranchModelBranch = "production"
+)
+
+type BranchRestrictionSearchOptions struct {
+	ListOptions
+
+	Type        BranchRestrictionType `url:"type,omitempty"`
+	MatcherType BranchMatcherType     `url:"matcherType,omitempty"`
+	MatcherID   string                `url:"matcherId,omitempty"`
+}
+
+// NewBranchMatcher returns a matcher for a single branch, e.g. "refs/heads/main".
+func NewBranchMatcher(ref string) BranchMatcher {
+	return BranchMatcher{ID: ref, Type: BranchMatcherTypeBranch, Active: true}
+}
+
+// NewPatternMatcher returns a matcher for refs matching the pattern, e.g. "release/*".
+func NewPatternMatcher(pattern string) BranchMatcher {
+	return BranchMatcher{ID: pattern, Type: BranchMatcherTypePattern, Active: true}
+}
+
+// NewModelCategoryMatcher returns a matcher for the branches of the branching model category.
+func NewModelCategoryMatcher(category BranchModelCategory) BranchMatcher {
+	return BranchMatcher{ID: string(category), Type: BranchMatcherTypeModelCategory, Active: true}
+}
+
+// NewModelBranchMatcher returns a matcher for the development or production branch of the branching model.
+func NewModelBranchMatcher(branch BranchModelBranch) BranchMatcher {
+	return BranchMatcher{ID: string(branch), Type: BranchMatcherTypeModelBranch, Active: true}
+}
+
+// NewAnyRefMatcher returns a matcher for all refs.
+func NewAnyRefMatcher() BranchMatcher {
+	return BranchMatcher{ID: anyRefMatcherID, Type: BranchMatcherTypeAnyRef, Active: true}
+}
+
+func newBranchRestriction(r InternalBranchRestriction) *BranchRestriction {
+	result := &BranchRestriction{
+		ID:   r.ID,
+		Type: r.Type,
+		Matcher: BranchMatcher{
+			ID:        r.Matcher.ID,
+			DisplayID: r.Matcher.DisplayID,
+			Type:      r.Matcher.Type.ID,
+			Active:    r.Matcher.Active,
+		},
+		Groups: r.Groups,
+	}
+	for _, u := range r.Users {
+		result.Users = append(result.Users, u.Name)
+	}
+	for _, k := range r.AccessKeys {
+		result.AccessKeys = append(result.AccessKeys, k.Key.ID)
+	}
+	return result
+}
+
+func newBranchRestrictionRequest(r *BranchRestriction) *branchRestrictionRequest {
+	req := &branchRestrictionRequest{
+		ID:         r.ID,
+		Type:       r.Type,
+		Users:      r.Users,
+		Groups:     r.Groups,
+		AccessKeys: r.AccessKeys,
+	}
+	req.Matcher.ID = r.Matcher.ID
+	req.Matcher.DisplayID = r.Matcher.DisplayID
+	req.Matcher.Type.ID = r.Matcher.Type
+	req.Matcher.Active = r.Matcher.Active
+	return req
+}
+
+func (s *BranchPermissionsService) listRestrictions(ctx context.Context, p string, opts *BranchRestrictionSearchOptions) ([]*BranchRestriction, *Response, error) {
+	var list BranchRestrictionList
+	resp, err := s.client.GetPaged(ctx, branchPermissionsApiName, p, &list, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	restrictions := make([]*BranchRestriction, 0, len(list.Restrictions))
+	for _, r := range list.Restrictions {
+		restrictions = append(restrictions, newBranchRestriction(r))
+	}
+	return restrictions, resp, nil
+}
+
+func (s *BranchPermissionsService) getRestriction(ctx context.Context, p string) (*BranchRestriction, *Response, error) {
+	var r InternalBranchRestriction
+	resp, err := s.client.Get(ctx, branchPermissionsApiName, p, &r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return newBranchRestriction(r), resp, nil
+}
+
+func (s *BranchPermissionsService) saveRestriction(ctx context.Context, p string, restriction *BranchRestriction) (*BranchRestriction, *Response, error) {
+	req, err := s.client.NewRequest("POST", branchPermissionsApiName, p, newBranchRestrictionRequest(restriction))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var r InternalBranchRestriction
+	resp, err := s.client.Do(ctx, req, &r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return newBranchRestriction(r), resp, nil
+}
+
+// updateRestriction saves the restriction in the collection at p, deleting the restriction with the ID
+// of the given restriction if the save created a new restriction. If the delete fails the new restriction
+// is returned with the error.
+func (s *BranchPermissionsService) updateRestriction(ctx context.Context, p string, restriction *BranchRestriction) (*BranchRestriction, *Response, error) {
+	r, resp, err := s.saveRestriction(ctx, p, restriction)
+	if err != nil {
+		return nil, resp, err
+	}
+	if r.ID == restriction.ID {
+		return r, resp, nil
+	}
+
+	resp, err = s.deleteRestriction(ctx, fmt.Sprintf("%s/%d", p, restriction.ID))
+	if err != nil {
+		return r, resp, fmt.Errorf("unable to delete replaced restriction %d: %w", restriction.ID, err)
+	}
+	return r, resp, nil
+}
+
+func (s *BranchPermissionsService) deleteRestriction(ctx context.Context, p string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", branchPermissionsApiName, p, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,239 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListProjectRestrictions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/branch-permissions/latest/projects/PRJ/restrictions", req.URL.Path)
+		assert.Equal(t, "PATTERN", req.URL.Query().Get("matcherType"))
+		rw.Write([]byte(listRestrictionsResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	restrictions, resp, err := client.BranchPermissions.ListProjectRestrictions(ctx, "PRJ", &BranchRestrictionSearchOptions{MatcherType: BranchMatcherTypePattern})
+	assert.NoError(t, err)
+	assert.True(t, resp.LastPage)
+	assert.Len(t, restrictions, 2)
+	assert.Equal(t, uint64(1), restrictions[0].ID)
+	assert.Equal(t, BranchRestrictionTypeNoDeletes, restrictions[0].Type)
+	assert.Equal(t, BranchMatcherTypePattern, restrictions[0].Matcher.Type)
+	assert.Equal(t, "release/*", restrictions[0].Matcher.ID)
+	assert.Equal(t, []string{"jdoe"}, restrictions[0].Users)
+	assert.Equal(t, []string{"release-managers"}, restrictions[0].Groups)
+	assert.Equal(t, []uint64{42}, restrictions[0].AccessKeys)
+	assert.Equal(t, BranchMatcherTypeModelCategory, restrictions[1].Matcher.Type)
+}
+
+func TestGetProjectRestriction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/branch-permissions/latest/projects/PRJ/restrictions/1", req.URL.Path)
+		rw.Write([]byte(getRestrictionResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	restriction, _, err := client.BranchPermissions.GetProjectRestriction(ctx, "PRJ", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, BranchRestrictionTypeNoDeletes, restriction.Type)
+	assert.True(t, restriction.Matcher.Active)
+}
+
+func TestCreateProjectRestriction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/branch-permissions/latest/projects/PRJ/restrictions", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"type\":\"no-deletes\",\"matcher\":{\"id\":\"release/*\",\"type\":{\"id\":\"PATTERN\"},\"active\":true},\"users\":[\"jdoe\"],\"groups\":[\"release-managers\"],\"accessKeys\":[42]}\n", string(b))
+		rw.Write([]byte(getRestrictionResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	in := &BranchRestriction{
+		Type:       BranchRestrictionTypeNoDeletes,
+		Matcher:    NewPatternMatcher("release/*"),
+		Users:      []string{"jdoe"},
+		Groups:     []string{"release-managers"},
+		AccessKeys: []uint64{42},
+	}
+	restriction, _, err := client.BranchPermissions.CreateProjectRestriction(ctx, "PRJ", in)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), restriction.ID)
+}
+
+func TestUpdateProjectRestriction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/branch-permissions/latest/projects/PRJ/restrictions", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"id\":1,\"type\":\"no-deletes\",\"matcher\":{\"id\":\"release/*\",\"type\":{\"id\":\"PATTERN\"},\"active\":true}}\n", string(b))
+		rw.Write([]byte(getRestrictionResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	in := &BranchRestriction{
+		Type:    BranchRestrictionTypeNoDeletes,
+		Matcher: NewPatternMatcher("release/*"),
+	}
+	_, _, err := client.BranchPermissions.UpdateProjectRestriction(ctx, "PRJ", in)
+	assert.Error(t, err)
+
+	in.ID = 1
+	_, _, err = client.BranchPermissions.UpdateProjectRestriction(ctx, "PRJ", in)
+	assert.NoError(t, err)
+}
+
+func TestUpdateProjectRestrictionMatcherChanged(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch req.Method {
+		case "POST":
+			b, _ := io.ReadAll(req.Body)
+			assert.Equal(t, "{\"id\":5,\"type\":\"no-deletes\",\"matcher\":{\"id\":\"release/*\",\"type\":{\"id\":\"PATTERN\"},\"active\":true}}\n", string(b))
+			rw.Write([]byte(getRestrictionResponse))
+		case "DELETE":
+			rw.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	in := &BranchRestriction{
+		ID:      5,
+		Type:    BranchRestrictionTypeNoDeletes,
+		Matcher: NewPatternMatcher("release/*"),
+	}
+	restriction, _, err := client.BranchPermissions.UpdateProjectRestriction(ctx, "PRJ", in)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), restriction.ID)
+	assert.Equal(t, []string{
+		"POST /branch-permissions/latest/projects/PRJ/restrictions",
+		"DELETE /branch-permissions/latest/projects/PRJ/restrictions/5",
+	}, requests)
+}
+
+func TestUpdateProjectRestrictionDeleteFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case "POST":
+			rw.Write([]byte(getRestrictionResponse))
+		case "DELETE":
+			rw.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	in := &BranchRestriction{
+		ID:      5,
+		Type:    BranchRestrictionTypeNoDeletes,
+		Matcher: NewPatternMatcher("release/*"),
+	}
+	restriction, resp, err := client.BranchPermissions.UpdateProjectRestriction(ctx, "PRJ", in)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	if assert.NotNil(t, restriction) {
+		assert.Equal(t, uint64(1), restriction.ID)
+	}
+}
+
+func TestDeleteProjectRestriction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/branch-permissions/latest/projects/PRJ/restrictions/1", req.URL.Path)
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.BranchPermissions.DeleteProjectRestriction(ctx, "PRJ", 1)
+	assert.NoError(t, err)
+}
+
+const getRestrictionResponse = `{
+  "id": 1,
+  "type": "no-deletes",
+  "matcher": {
+    "id": "release/*",
+    "displayId": "release/*",
+    "type": {
+      "id": "PATTERN",
+      "name": "Pattern"
+    },
+    "active": true
+  },
+  "users": [
+    {
+      "name": "jdoe",
+      "emailAddress": "jdoe@mymail.dk",
+      "active": true,
+      "displayName": "John Doe",
+      "id": 42,
+      "slug": "jdoe",
+      "type": "NORMAL"
+    }
+  ],
+  "groups": [
+    "release-managers"
+  ],
+  "accessKeys": [
+    {
+      "key": {
+        "id": 42,
+        "text": "ssh-rsa AAAAB3... deploy@ci",
+        "label": "deploy@ci"
+      },
+      "permission": "REPO_WRITE"
+    }
+  ],
+  "scope": {
+    "type": "PROJECT",
+    "resourceId": 363
+  }
+}`
+
+const listRestrictionsResponse = `{
+  "size": 2,
+  "limit": 25,
+  "isLastPage": true,
+  "values": [
+    ` + getRestrictionResponse + `,
+    {
+      "id": 2,
+      "type": "pull-request-only",
+      "matcher": {
+        "id": "RELEASE",
+        "displayId": "Release",
+        "type": {
+          "id": "MODEL_CATEGORY",
+          "name": "Branching model category"
+        },
+        "active": true
+      },
+      "users": [],
+      "groups": [],
+      "accessKeys": []
+    }
+  ],
+  "start": 0
+}`
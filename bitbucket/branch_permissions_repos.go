@@ -0,0 +1,49 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+func (s *BranchPermissionsService) ListRepositoryRestrictions(ctx context.Context, projectKey, repositorySlug string, opts *BranchRestrictionSearchOptions) ([]*BranchRestriction, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/restrictions", projectKey, repositorySlug)
+	return s.listRestrictions(ctx, p, opts)
+}
+
+func (s *BranchPermissionsService) ListRepositoryRestrictionsIter(ctx context.Context, projectKey, repositorySlug string, opts *BranchRestrictionSearchOptions) iter.Seq2[*BranchRestriction, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*BranchRestriction, *Response, error) {
+		return s.ListRepositoryRestrictions(ctx, projectKey, repositorySlug, o)
+	})
+}
+
+func (s *BranchPermissionsService) GetRepositoryRestriction(ctx context.Context, projectKey, repositorySlug string, id uint64) (*BranchRestriction, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/restrictions/%d", projectKey, repositorySlug, id)
+	return s.getRestriction(ctx, p)
+}
+
+func (s *BranchPermissionsService) CreateRepositoryRestriction(ctx context.Context, projectKey, repositorySlug string, restriction *BranchRestriction) (*BranchRestriction, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/restrictions", projectKey, repositorySlug)
+	return s.saveRestriction(ctx, p, restriction)
+}
+
+// UpdateRepositoryRestriction updates the restriction identified by the ID of the given restriction. Bitbucket
+// matches restrictions by type and matcher, so if either is changed a new restriction is created and the
+// restriction with the given ID is deleted. The returned restriction may thus have a different ID.
+//
+// The update is not atomic: if deleting the old restriction fails, the new restriction is returned along
+// with the error and both restrictions exist. If another restriction already has the new type and matcher,
+// its exemptions are overwritten by those of the given restriction before the old one is deleted.
+func (s *BranchPermissionsService) UpdateRepositoryRestriction(ctx context.Context, projectKey, repositorySlug string, restriction *BranchRestriction) (*BranchRestriction, *Response, error) {
+	if restriction.ID == 0 {
+		return nil, nil, fmt.Errorf("restriction id must be set to update restriction")
+	}
+	p := fmt.Sprintf("projects/%s/repos/%s/restrictions", projectKey, repositorySlug)
+	return s.updateRestriction(ctx, p, restriction)
+}
+
+func (s *BranchPermissionsService) DeleteRepositoryRestriction(ctx context.Context, projectKey, repositorySlug string, id uint64) (*Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/restrictions/%d", projectKey, repositorySlug, id)
+	return s.deleteRestriction(ctx, p)
+}
@@ -0,0 +1,105 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRepositoryRestrictions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/branch-permissions/latest/projects/PRJ/repos/repo/restrictions", req.URL.Path)
+		assert.Equal(t, "pull-request-only", req.URL.Query().Get("type"))
+		rw.Write([]byte(listRestrictionsResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	restrictions, _, err := client.BranchPermissions.ListRepositoryRestrictions(ctx, "PRJ", "repo", &BranchRestrictionSearchOptions{Type: BranchRestrictionTypePullRequestOnly})
+	assert.NoError(t, err)
+	assert.Len(t, restrictions, 2)
+}
+
+func TestGetRepositoryRestriction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/branch-permissions/latest/projects/PRJ/repos/repo/restrictions/1", req.URL.Path)
+		rw.Write([]byte(getRestrictionResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	restriction, _, err := client.BranchPermissions.GetRepositoryRestriction(ctx, "PRJ", "repo", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), restriction.ID)
+}
+
+func TestCreateRepositoryRestriction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/branch-permissions/latest/projects/PRJ/repos/repo/restrictions", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"type\":\"fast-forward-only\",\"matcher\":{\"id\":\"refs/heads/main\",\"type\":{\"id\":\"BRANCH\"},\"active\":true}}\n", string(b))
+		rw.Write([]byte(getRestrictionResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	in := &BranchRestriction{
+		Type:    BranchRestrictionTypeFastForwardOnly,
+		Matcher: NewBranchMatcher("refs/heads/main"),
+	}
+	_, _, err := client.BranchPermissions.CreateRepositoryRestriction(ctx, "PRJ", "repo", in)
+	assert.NoError(t, err)
+}
+
+func TestUpdateRepositoryRestriction(t *testing.T) {
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == "DELETE" {
+			deleted = append(deleted, req.URL.Path)
+			rw.WriteHeader(http.StatusNoContent)
+			return
+		}
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/branch-permissions/latest/projects/PRJ/repos/repo/restrictions", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"id\":7,\"type\":\"read-only\",\"matcher\":{\"id\":\"ANY_REF_MATCHER_ID\",\"type\":{\"id\":\"ANY_REF\"},\"active\":true}}\n", string(b))
+		rw.Write([]byte(getRestrictionResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	in := &BranchRestriction{
+		ID:      7,
+		Type:    BranchRestrictionTypeReadOnly,
+		Matcher: NewAnyRefMatcher(),
+	}
+	_, _, err := client.BranchPermissions.UpdateRepositoryRestriction(ctx, "PRJ", "repo", in)
+	assert.NoError(t, err)
+	// The matcher changed so Bitbucket created restriction 1, replacing restriction 7.
+	assert.Equal(t, []string{"/branch-permissions/latest/projects/PRJ/repos/repo/restrictions/7"}, deleted)
+}
+
+func TestDeleteRepositoryRestriction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/branch-permissions/latest/projects/PRJ/repos/repo/restrictions/1", req.URL.Path)
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.BranchPermissions.DeleteRepositoryRestriction(ctx, "PRJ", "repo", 1)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,91 @@
+package bitbucket
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Sentinel errors matched by *ErrorResponse using errors.Is based on the response status code.
+var (
+	ErrUnauthorized = errors.New("bitbucket: unauthorized")
+	ErrForbidden    = errors.New("bitbucket: forbidden")
+	ErrNotFound     = errors.New("bitbucket: not found")
+	ErrConflict     = errors.New("bitbucket: conflict")
+	ErrRateLimited  = errors.New("bitbucket: rate limited")
+)
+
+// Exception names reported by Bitbucket in ErrorMessage.Exception.
+const (
+	ExceptionAuthorisation        = "com.atlassian.bitbucket.AuthorisationException"
+	ExceptionNoSuchProject        = "com.atlassian.bitbucket.project.NoSuchProjectException"
+	ExceptionNoSuchRepository     = "com.atlassian.bitbucket.repository.NoSuchRepositoryException"
+	ExceptionRepositoryExists     = "com.atlassian.bitbucket.repository.RepositoryExistsException"
+	ExceptionNoSuchPullRequest    = "com.atlassian.bitbucket.pull.NoSuchPullRequestException"
+	ExceptionDuplicatePullRequest = "com.atlassian.bitbucket.pull.DuplicatePullRequestException"
+	ExceptionPullRequestOutOfDate = "com.atlassian.bitbucket.pull.PullRequestOutOfDateException"
+)
+
+// Is reports whether the error response matches one of the sentinel errors, e.g. ErrNotFound.
+func (e *ErrorResponse) Is(target error) bool {
+	if e.Response == nil {
+		return false
+	}
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// HasException reports whether one of the error messages carries the given exception name.
+func (e *ErrorResponse) HasException(exceptionName string) bool {
+	for _, m := range e.Errors {
+		if m.Exception == exceptionName {
+			return true
+		}
+	}
+	return false
+}
+
+// IsUnauthorized reports whether err is a Bitbucket 401 Unauthorized error.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsForbidden reports whether err is a Bitbucket 403 Forbidden error.
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+// IsNotFound reports whether err is a Bitbucket 404 Not Found error.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict reports whether err is a Bitbucket 409 Conflict error.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsRateLimited reports whether err is a Bitbucket 429 Too Many Requests error.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsException reports whether err is a Bitbucket error carrying the given exception name,
+// e.g. ExceptionRepositoryExists.
+func IsException(err error, exceptionName string) bool {
+	var er *ErrorResponse
+	if !errors.As(err, &er) {
+		return false
+	}
+	return er.HasException(exceptionName)
+}
@@ -0,0 +1,48 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorClassification(t *testing.T) {
+	tests := []struct {
+		status int
+		check  func(error) bool
+	}{
+		{http.StatusUnauthorized, IsUnauthorized},
+		{http.StatusForbidden, IsForbidden},
+		{http.StatusNotFound, IsNotFound},
+		{http.StatusConflict, IsConflict},
+		{http.StatusTooManyRequests, IsRateLimited},
+	}
+	for _, tc := range tests {
+		err := error(&ErrorResponse{Response: &http.Response{StatusCode: tc.status}})
+		assert.True(t, tc.check(err), "status %d", tc.status)
+		assert.True(t, tc.check(fmt.Errorf("wrapped: %w", err)), "status %d", tc.status)
+		assert.False(t, tc.check(&ErrorResponse{Response: &http.Response{StatusCode: http.StatusInternalServerError}}))
+	}
+	assert.False(t, IsNotFound(nil))
+	assert.False(t, IsNotFound(fmt.Errorf("not found")))
+}
+
+func TestIsException(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusConflict)
+		rw.Write([]byte(repositoryExistsErrorResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.CreateRepository(ctx, "PRJ", &Repository{Name: "repo", ScmID: "git"})
+	assert.True(t, IsConflict(err))
+	assert.True(t, IsException(err, ExceptionRepositoryExists))
+	assert.False(t, IsException(err, ExceptionNoSuchRepository))
+	assert.False(t, IsException(fmt.Errorf("plain"), ExceptionRepositoryExists))
+}
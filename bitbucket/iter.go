@@ -0,0 +1,60 @@
+package bitbucket
+
+import (
+	"context"
+	"iter"
+)
+
+// pageFunc fetches a single page using the start set in the list options shared with paginate.
+type pageFunc[T any] func(ctx context.Context) ([]T, *Response, error)
+
+// paginate returns an iterator calling list for every page until the last page is reached. The
+// start of opts is advanced between pages and reset every time the iterator is started.
+func paginate[T any](ctx context.Context, opts *ListOptions, list pageFunc[T]) iter.Seq2[T, error] {
+	start := opts.Start
+	return func(yield func(T, error) bool) {
+		opts.Start = start
+		for {
+			values, resp, err := list(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, v := range values {
+				if !yield(v, nil) {
+					return
+				}
+			}
+			if resp == nil || resp.Page == nil || resp.LastPage || resp.NextPageStart <= opts.Start {
+				return
+			}
+			opts.Start = resp.NextPageStart
+		}
+	}
+}
+
+// All collects the values of a paged iterator, e.g. ProjectsService.SearchRepositoriesIter, stopping at
+// the first error. At most max values are returned if max is greater than zero.
+func All[T any](seq iter.Seq2[T, error], max int) ([]T, error) {
+	all := make([]T, 0)
+	for v, err := range seq {
+		if err != nil {
+			return all, err
+		}
+		all = append(all, v)
+		if max > 0 && len(all) >= max {
+			break
+		}
+	}
+	return all, nil
+}
+
+// copyOptions copies the options to avoid modifying the start of the options passed by the caller.
+func copyOptions[O any](opts *O) *O {
+	var o O
+	if opts != nil {
+		o = *opts
+	}
+	return &o
+}
@@ -0,0 +1,88 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchRepositoriesIter(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/repos", req.URL.Path)
+		assert.Equal(t, "AVAILABLE", req.URL.Query().Get("state"))
+		calls++
+		switch req.URL.Query().Get("start") {
+		case "0":
+			rw.Write([]byte(`{"size":2,"limit":2,"isLastPage":false,"start":0,"nextPageStart":2,"values":[{"slug":"a"},{"slug":"b"}]}`))
+		case "2":
+			rw.Write([]byte(`{"size":1,"limit":2,"isLastPage":true,"start":2,"values":[{"slug":"c"}]}`))
+		default:
+			t.Errorf("unexpected start: %s", req.URL.Query().Get("start"))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	opts := &RepositorySearchOptions{State: RepositoryStateAvailable}
+	slugs := []string{}
+	for r, err := range client.Projects.SearchRepositoriesIter(ctx, opts) {
+		assert.NoError(t, err)
+		slugs = append(slugs, r.Slug)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, slugs)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, uint(0), opts.Start)
+
+	repos, err := All(client.Projects.SearchRepositoriesIter(ctx, opts), 0)
+	assert.NoError(t, err)
+	assert.Len(t, repos, 3)
+	assert.Equal(t, 4, calls)
+
+	repos, err = All(client.Projects.SearchRepositoriesIter(ctx, opts), 2)
+	assert.NoError(t, err)
+	assert.Len(t, repos, 2)
+	assert.Equal(t, 5, calls)
+}
+
+func TestListProjectsIterError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("start") == "0" {
+			rw.Write([]byte(`{"size":1,"limit":1,"isLastPage":false,"start":0,"nextPageStart":1,"values":[{"key":"PRJ"}]}`))
+			return
+		}
+		rw.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	projects, err := All(client.Projects.ListProjectsIter(ctx, nil), 0)
+	assert.Error(t, err)
+	assert.Len(t, projects, 1)
+	assert.Equal(t, "PRJ", projects[0].Key)
+}
+
+func TestAll(t *testing.T) {
+	seq := func(yield func(int, error) bool) {
+		for i := range 5 {
+			if !yield(i, nil) {
+				return
+			}
+		}
+		yield(0, fmt.Errorf("failed"))
+	}
+	values, err := All(seq, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2}, values)
+
+	values, err = All(seq, 0)
+	assert.Error(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, values)
+}
@@ -3,6 +3,7 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 type SshKeyList struct {
@@ -45,6 +46,13 @@ func (s *KeysService) ListRepositoryKeys(ctx context.Context, projectKey, reposi
 	return keys, resp, nil
 }
 
+func (s *KeysService) ListRepositoryKeysIter(ctx context.Context, projectKey, repositorySlug string, opts *ListOptions) iter.Seq2[*SshKey, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, o, func(ctx context.Context) ([]*SshKey, *Response, error) {
+		return s.ListRepositoryKeys(ctx, projectKey, repositorySlug, o)
+	})
+}
+
 func (s *KeysService) GetRepositoryKey(ctx context.Context, projectKey, repositorySlug string, keyId uint64) (*SshKey, *Response, error) {
 	p := fmt.Sprintf("projects/%s/repos/%s/ssh/%d", projectKey, repositorySlug, keyId)
 	var k InternalSshKey
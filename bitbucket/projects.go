@@ -3,6 +3,7 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 type ProjectsService service
@@ -10,10 +11,42 @@ type ProjectsService service
 const projectsApiName = "api"
 
 type Project struct {
-	ID    uint64            `json:"id,omitempty"`
-	Key   string            `json:"key,omitempty"`
-	Name  string            `json:"name"`
-	Links map[string][]Link `json:"links,omitempty"`
+	ID          uint64            `json:"id,omitempty"`
+	Key         string            `json:"key,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Public      bool              `json:"public"`
+	Type        ProjectType       `json:"type,omitempty"`
+	Links       map[string][]Link `json:"links,omitempty"`
+
+	// Avatar is the project avatar as data URI, e.g. "data:image/png;base64,...", only used when creating or updating.
+	Avatar string `json:"avatar,omitempty"`
+}
+
+// ProjectUpdate defines the changes to a project, only fields set are changed.
+type ProjectUpdate struct {
+	// Key is the new key of the project, changing the key moves the project.
+	Key         *string `json:"key,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Public      *bool   `json:"public,omitempty"`
+
+	// Avatar is the new project avatar as data URI, e.g. "data:image/png;base64,...".
+	Avatar *string `json:"avatar,omitempty"`
+}
+
+type ProjectType string
+
+const (
+	ProjectTypeNormal   ProjectType = "NORMAL"
+	ProjectTypePersonal ProjectType = "PERSONAL"
+)
+
+type ProjectSearchOptions struct {
+	ListOptions
+
+	Name       string     `url:"name,omitempty"`
+	Permission Permission `url:"permission,omitempty"`
 }
 
 type ProjectList struct {
@@ -56,6 +89,80 @@ func (s *ProjectsService) ListProjects(ctx context.Context, opts *ListOptions) (
 	return l.Projects, resp, nil
 }
 
+func (s *ProjectsService) ListProjectsIter(ctx context.Context, opts *ListOptions) iter.Seq2[*Project, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, o, func(ctx context.Context) ([]*Project, *Response, error) {
+		return s.ListProjects(ctx, o)
+	})
+}
+
+// SearchProjects lists the projects matching the name and the permission of the user.
+func (s *ProjectsService) SearchProjects(ctx context.Context, opts *ProjectSearchOptions) ([]*Project, *Response, error) {
+	var l ProjectList
+	resp, err := s.client.GetPaged(ctx, projectsApiName, "projects", &l, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Projects, resp, nil
+}
+
+func (s *ProjectsService) SearchProjectsIter(ctx context.Context, opts *ProjectSearchOptions) iter.Seq2[*Project, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*Project, *Response, error) {
+		return s.SearchProjects(ctx, o)
+	})
+}
+
+func (s *ProjectsService) GetProject(ctx context.Context, projectKey string) (*Project, *Response, error) {
+	p := fmt.Sprintf("projects/%s", projectKey)
+	var pr Project
+	resp, err := s.client.Get(ctx, projectsApiName, p, &pr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &pr, resp, nil
+}
+
+func (s *ProjectsService) CreateProject(ctx context.Context, project *Project) (*Project, *Response, error) {
+	req, err := s.client.NewRequest("POST", projectsApiName, "projects", project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pr Project
+	resp, err := s.client.Do(ctx, req, &pr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &pr, resp, nil
+}
+
+// UpdateProject updates the project identified by projectKey. The project key is changed if the
+// update sets a key different from projectKey.
+func (s *ProjectsService) UpdateProject(ctx context.Context, projectKey string, update *ProjectUpdate) (*Project, *Response, error) {
+	p := fmt.Sprintf("projects/%s", projectKey)
+	req, err := s.client.NewRequest("PUT", projectsApiName, p, update)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pr Project
+	resp, err := s.client.Do(ctx, req, &pr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &pr, resp, nil
+}
+
+func (s *ProjectsService) DeleteProject(ctx context.Context, projectKey string) (*Response, error) {
+	p := fmt.Sprintf("projects/%s", projectKey)
+	req, err := s.client.NewRequest("DELETE", projectsApiName, p, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
 func (s *ProjectsService) SearchProjectPermissions(ctx context.Context, projectKey string, opts *ProjectPermissionSearchOptions) ([]*ProjectPermission, *Response, error) {
 	p := fmt.Sprintf("projects/%s/permissions/search", projectKey)
 	var l ProjectPermissionList
@@ -65,3 +172,10 @@ func (s *ProjectsService) SearchProjectPermissions(ctx context.Context, projectK
 	}
 	return l.Permissions, resp, nil
 }
+
+func (s *ProjectsService) SearchProjectPermissionsIter(ctx context.Context, projectKey string, opts *ProjectPermissionSearchOptions) iter.Seq2[*ProjectPermission, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*ProjectPermission, *Response, error) {
+		return s.SearchProjectPermissions(ctx, projectKey, o)
+	})
+}
@@ -0,0 +1,142 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// BranchModel is the effective branching model of a repository.
+type BranchModel struct {
+	Development *Branch           `json:"development,omitempty"`
+	Production  *Branch           `json:"production,omitempty"`
+	Types       []BranchModelType `json:"types"`
+}
+
+type BranchModelType struct {
+	ID          BranchModelCategory `json:"id"`
+	DisplayName string              `json:"displayName,omitempty"`
+	Prefix      string              `json:"prefix"`
+}
+
+// BranchModelConfiguration is the branching model configured for a project or repository.
+type BranchModelConfiguration struct {
+	Development BranchModelRef                 `json:"development"`
+	Production  *BranchModelRef                `json:"production,omitempty"`
+	Types       []BranchModelTypeConfiguration `json:"types"`
+	Scope       *BranchModelConfigurationScope `json:"scope,omitempty"`
+}
+
+// BranchModelRef references the development or production branch, e.g. "refs/heads/develop", or the
+// default branch of the repository if UseDefault is set.
+type BranchModelRef struct {
+	RefID      string `json:"refId,omitempty"`
+	UseDefault bool   `json:"useDefault"`
+}
+
+type BranchModelTypeConfiguration struct {
+	ID          BranchModelCategory `json:"id"`
+	DisplayName string              `json:"displayName,omitempty"`
+	Enabled     bool                `json:"enabled"`
+	Prefix      string              `json:"prefix"`
+}
+
+type BranchModelConfigurationScope struct {
+	Type       string `json:"type"`
+	ResourceID uint64 `json:"resourceId"`
+}
+
+// Classify returns the category of the branch based on the prefixes of the model and whether the branch
+// is the development or production branch. Empty values are returned if the branch does not match.
+func (m *BranchModel) Classify(branch *Branch) (BranchModelCategory, BranchModelBranch) {
+	var modelBranch BranchModelBranch
+	if m.Development != nil && m.Development.ID == branch.ID {
+		modelBranch = BranchModelBranchDevelopment
+	} else if m.Production != nil && m.Production.ID == branch.ID {
+		modelBranch = BranchModelBranchProduction
+	}
+
+	name := strings.TrimPrefix(branch.ID, "refs/heads/")
+	for _, t := range m.Types {
+		if t.Prefix != "" && strings.HasPrefix(name, t.Prefix) {
+			return t.ID, modelBranch
+		}
+	}
+	return "", modelBranch
+}
+
+// GetBranchModel returns the branching model in effect for the repository.
+func (s *ProjectsService) GetBranchModel(ctx context.Context, projectKey, repositorySlug string) (*BranchModel, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/branchmodel", projectKey, repositorySlug)
+	var m BranchModel
+	resp, err := s.client.Get(ctx, branchUtilsApiName, p, &m)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &m, resp, nil
+}
+
+func (s *ProjectsService) GetProjectBranchModelConfiguration(ctx context.Context, projectKey string) (*BranchModelConfiguration, *Response, error) {
+	p := fmt.Sprintf("projects/%s/branchmodel/configuration", projectKey)
+	return s.getBranchModelConfiguration(ctx, p)
+}
+
+func (s *ProjectsService) UpdateProjectBranchModelConfiguration(ctx context.Context, projectKey string, config *BranchModelConfiguration) (*BranchModelConfiguration, *Response, error) {
+	p := fmt.Sprintf("projects/%s/branchmodel/configuration", projectKey)
+	return s.updateBranchModelConfiguration(ctx, p, config)
+}
+
+// DeleteProjectBranchModelConfiguration removes the project configuration resetting the project to the
+// default branching model.
+func (s *ProjectsService) DeleteProjectBranchModelConfiguration(ctx context.Context, projectKey string) (*Response, error) {
+	p := fmt.Sprintf("projects/%s/branchmodel/configuration", projectKey)
+	return s.deleteBranchModelConfiguration(ctx, p)
+}
+
+func (s *ProjectsService) GetRepositoryBranchModelConfiguration(ctx context.Context, projectKey, repositorySlug string) (*BranchModelConfiguration, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/branchmodel/configuration", projectKey, repositorySlug)
+	return s.getBranchModelConfiguration(ctx, p)
+}
+
+func (s *ProjectsService) UpdateRepositoryBranchModelConfiguration(ctx context.Context, projectKey, repositorySlug string, config *BranchModelConfiguration) (*BranchModelConfiguration, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/branchmodel/configuration", projectKey, repositorySlug)
+	return s.updateBranchModelConfiguration(ctx, p, config)
+}
+
+// DeleteRepositoryBranchModelConfiguration removes the repository configuration making the repository
+// inherit the branching model of the project.
+func (s *ProjectsService) DeleteRepositoryBranchModelConfiguration(ctx context.Context, projectKey, repositorySlug string) (*Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/branchmodel/configuration", projectKey, repositorySlug)
+	return s.deleteBranchModelConfiguration(ctx, p)
+}
+
+func (s *ProjectsService) getBranchModelConfiguration(ctx context.Context, p string) (*BranchModelConfiguration, *Response, error) {
+	var c BranchModelConfiguration
+	resp, err := s.client.Get(ctx, branchUtilsApiName, p, &c)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &c, resp, nil
+}
+
+func (s *ProjectsService) updateBranchModelConfiguration(ctx context.Context, p string, config *BranchModelConfiguration) (*BranchModelConfiguration, *Response, error) {
+	req, err := s.client.NewRequest("PUT", branchUtilsApiName, p, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var c BranchModelConfiguration
+	resp, err := s.client.Do(ctx, req, &c)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &c, resp, nil
+}
+
+func (s *ProjectsService) deleteBranchModelConfiguration(ctx context.Context, p string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", branchUtilsApiName, p, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
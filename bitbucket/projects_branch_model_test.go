@@ -0,0 +1,177 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBranchModel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/branch-utils/latest/projects/PRJ/repos/repo/branchmodel", req.URL.Path)
+		rw.Write([]byte(getBranchModelResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	model, _, err := client.Projects.GetBranchModel(ctx, "PRJ", "repo")
+	assert.NoError(t, err)
+	assert.Equal(t, "refs/heads/main", model.Development.ID)
+	assert.Nil(t, model.Production)
+	assert.Len(t, model.Types, 3)
+
+	category, branch := model.Classify(&Branch{ID: "refs/heads/feature/login"})
+	assert.Equal(t, BranchModelCategoryFeature, category)
+	assert.Empty(t, branch)
+	category, branch = model.Classify(&Branch{ID: "refs/heads/main"})
+	assert.Empty(t, category)
+	assert.Equal(t, BranchModelBranchDevelopment, branch)
+	category, branch = model.Classify(&Branch{ID: "refs/heads/hotfix/cve"})
+	assert.Empty(t, category)
+	assert.Empty(t, branch)
+}
+
+func TestGetProjectBranchModelConfiguration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/branch-utils/latest/projects/PRJ/branchmodel/configuration", req.URL.Path)
+		rw.Write([]byte(getBranchModelConfigurationResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	config, _, err := client.Projects.GetProjectBranchModelConfiguration(ctx, "PRJ")
+	assert.NoError(t, err)
+	assert.True(t, config.Development.UseDefault)
+	assert.Equal(t, "refs/heads/production", config.Production.RefID)
+	assert.Len(t, config.Types, 4)
+	assert.False(t, config.Types[2].Enabled)
+	assert.Equal(t, "PROJECT", config.Scope.Type)
+}
+
+func TestUpdateRepositoryBranchModelConfiguration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/branch-utils/latest/projects/PRJ/repos/repo/branchmodel/configuration", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"development\":{\"useDefault\":true},\"types\":[{\"id\":\"FEATURE\",\"enabled\":true,\"prefix\":\"feature/\"},{\"id\":\"HOTFIX\",\"enabled\":false,\"prefix\":\"hotfix/\"}]}\n", string(b))
+		rw.Write([]byte(getBranchModelConfigurationResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	in := &BranchModelConfiguration{
+		Development: BranchModelRef{UseDefault: true},
+		Types: []BranchModelTypeConfiguration{
+			{ID: BranchModelCategoryFeature, Enabled: true, Prefix: "feature/"},
+			{ID: BranchModelCategoryHotfix, Enabled: false, Prefix: "hotfix/"},
+		},
+	}
+	_, _, err := client.Projects.UpdateRepositoryBranchModelConfiguration(ctx, "PRJ", "repo", in)
+	assert.NoError(t, err)
+}
+
+func TestDeleteRepositoryBranchModelConfiguration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/branch-utils/latest/projects/PRJ/repos/repo/branchmodel/configuration", req.URL.Path)
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.Projects.DeleteRepositoryBranchModelConfiguration(ctx, "PRJ", "repo")
+	assert.NoError(t, err)
+}
+
+func TestDeleteProjectBranchModelConfiguration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/branch-utils/latest/projects/PRJ/branchmodel/configuration", req.URL.Path)
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.Projects.DeleteProjectBranchModelConfiguration(ctx, "PRJ")
+	assert.NoError(t, err)
+}
+
+const getBranchModelResponse = `{
+  "development": {
+    "id": "refs/heads/main",
+    "displayId": "main",
+    "type": "BRANCH",
+    "latestCommit": "8d51122def5632836d1cb1026e879069e10a1e13",
+    "latestChangeset": "8d51122def5632836d1cb1026e879069e10a1e13",
+    "isDefault": true
+  },
+  "types": [
+    {
+      "id": "BUGFIX",
+      "displayName": "Bugfix",
+      "prefix": "bugfix/"
+    },
+    {
+      "id": "FEATURE",
+      "displayName": "Feature",
+      "prefix": "feature/"
+    },
+    {
+      "id": "RELEASE",
+      "displayName": "Release",
+      "prefix": "release/"
+    }
+  ]
+}`
+
+const getBranchModelConfigurationResponse = `{
+  "development": {
+    "refId": null,
+    "useDefault": true
+  },
+  "production": {
+    "refId": "refs/heads/production",
+    "useDefault": false
+  },
+  "types": [
+    {
+      "id": "BUGFIX",
+      "displayName": "Bugfix",
+      "enabled": true,
+      "prefix": "bugfix/"
+    },
+    {
+      "id": "FEATURE",
+      "displayName": "Feature",
+      "enabled": true,
+      "prefix": "feature/"
+    },
+    {
+      "id": "HOTFIX",
+      "displayName": "Hotfix",
+      "enabled": false,
+      "prefix": "hotfix/"
+    },
+    {
+      "id": "RELEASE",
+      "displayName": "Release",
+      "enabled": true,
+      "prefix": "release/"
+    }
+  ],
+  "scope": {
+    "type": "PROJECT",
+    "resourceId": 363
+  }
+}`
@@ -0,0 +1,163 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+type PermissionListOptions struct {
+	ListOptions
+
+	Filter string `url:"filter,omitempty"`
+}
+
+type UserPermissionList struct {
+	ListResponse
+	Permissions []*UserPermission `json:"values"`
+}
+
+type UserPermission struct {
+	User       User       `json:"user"`
+	Permission Permission `json:"permission"`
+}
+
+type GroupPermissionList struct {
+	ListResponse
+	Permissions []*GroupPermission `json:"values"`
+}
+
+type GroupPermission struct {
+	Group struct {
+		Name string `json:"name"`
+	} `json:"group"`
+	Permission Permission `json:"permission"`
+}
+
+type permissionOptions struct {
+	Names      []string   `url:"name"`
+	Permission Permission `url:"permission,omitempty"`
+}
+
+type DefaultPermission struct {
+	Permitted bool `json:"permitted"`
+}
+
+type defaultPermissionOptions struct {
+	Allow bool `url:"allow"`
+}
+
+func (s *ProjectsService) ListProjectUserPermissions(ctx context.Context, projectKey string, opts *PermissionListOptions) ([]*ProjectPermission, *Response, error) {
+	p := fmt.Sprintf("projects/%s/permissions/users", projectKey)
+	var l UserPermissionList
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &l, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return newUserPermissions(l.Permissions), resp, nil
+}
+
+func (s *ProjectsService) ListProjectUserPermissionsIter(ctx context.Context, projectKey string, opts *PermissionListOptions) iter.Seq2[*ProjectPermission, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*ProjectPermission, *Response, error) {
+		return s.ListProjectUserPermissions(ctx, projectKey, o)
+	})
+}
+
+func (s *ProjectsService) ListProjectGroupPermissions(ctx context.Context, projectKey string, opts *PermissionListOptions) ([]*ProjectPermission, *Response, error) {
+	p := fmt.Sprintf("projects/%s/permissions/groups", projectKey)
+	var l GroupPermissionList
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &l, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return newGroupPermissions(l.Permissions), resp, nil
+}
+
+func (s *ProjectsService) ListProjectGroupPermissionsIter(ctx context.Context, projectKey string, opts *PermissionListOptions) iter.Seq2[*ProjectPermission, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*ProjectPermission, *Response, error) {
+		return s.ListProjectGroupPermissions(ctx, projectKey, o)
+	})
+}
+
+// GrantProjectPermission grants the permission, e.g. PermissionProjectWrite, to the named users or groups
+// depending on the permission type.
+func (s *ProjectsService) GrantProjectPermission(ctx context.Context, projectKey string, permissionType ProjectPermissionType, permission Permission, names ...string) (*Response, error) {
+	if len(names) == 0 {
+		return nil, fmt.Errorf("names must be set to grant permission")
+	}
+	p, err := permissionPath(fmt.Sprintf("projects/%s", projectKey), permissionType)
+	if err != nil {
+		return nil, err
+	}
+	return s.updatePermissions(ctx, "PUT", p, &permissionOptions{Names: names, Permission: permission})
+}
+
+// RevokeProjectPermission revokes all project permissions from the named user or group depending on
+// the permission type.
+func (s *ProjectsService) RevokeProjectPermission(ctx context.Context, projectKey string, permissionType ProjectPermissionType, name string) (*Response, error) {
+	if name == "" {
+		return nil, fmt.Errorf("name must be set to revoke permission")
+	}
+	p, err := permissionPath(fmt.Sprintf("projects/%s", projectKey), permissionType)
+	if err != nil {
+		return nil, err
+	}
+	return s.updatePermissions(ctx, "DELETE", p, &permissionOptions{Names: []string{name}})
+}
+
+// GetProjectDefaultPermission reports whether the permission is granted to all users by default.
+func (s *ProjectsService) GetProjectDefaultPermission(ctx context.Context, projectKey string, permission Permission) (bool, *Response, error) {
+	p := fmt.Sprintf("projects/%s/permissions/%s/all", projectKey, permission)
+	var d DefaultPermission
+	resp, err := s.client.Get(ctx, projectsApiName, p, &d)
+	if err != nil {
+		return false, resp, err
+	}
+	return d.Permitted, resp, nil
+}
+
+// SetProjectDefaultPermission grants or revokes the permission, e.g. PermissionProjectRead, for all users.
+func (s *ProjectsService) SetProjectDefaultPermission(ctx context.Context, projectKey string, permission Permission, allow bool) (*Response, error) {
+	p := fmt.Sprintf("projects/%s/permissions/%s/all", projectKey, permission)
+	return s.updatePermissions(ctx, "POST", p, &defaultPermissionOptions{Allow: allow})
+}
+
+func (s *ProjectsService) updatePermissions(ctx context.Context, method, path string, opts interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(method, projectsApiName, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = addOptions(req, opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+func permissionPath(prefix string, permissionType ProjectPermissionType) (string, error) {
+	switch permissionType {
+	case ProjectPermissionTypeUser:
+		return prefix + "/permissions/users", nil
+	case ProjectPermissionTypeGroup:
+		return prefix + "/permissions/groups", nil
+	}
+	return "", fmt.Errorf("unsupported permission type: %s", permissionType)
+}
+
+func newUserPermissions(l []*UserPermission) []*ProjectPermission {
+	perms := make([]*ProjectPermission, 0, len(l))
+	for _, p := range l {
+		perms = append(perms, &ProjectPermission{Permission: p.Permission, User: p.User})
+	}
+	return perms
+}
+
+func newGroupPermissions(l []*GroupPermission) []*ProjectPermission {
+	perms := make([]*ProjectPermission, 0, len(l))
+	for _, p := range l {
+		perms = append(perms, &ProjectPermission{Permission: p.Permission, Group: p.Group.Name})
+	}
+	return perms
+}
@@ -0,0 +1,146 @@
+package bitbucket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListProjectUserPermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/permissions/users", req.URL.Path)
+		assert.Equal(t, "jdoe", req.URL.Query().Get("filter"))
+		rw.Write([]byte(listUserPermissionsResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	perms, resp, err := client.Projects.ListProjectUserPermissions(ctx, "PRJ", &PermissionListOptions{Filter: "jdoe"})
+	assert.NoError(t, err)
+	assert.True(t, resp.LastPage)
+	assert.Len(t, perms, 1)
+	assert.Equal(t, "jdoe", perms[0].User.Slug)
+	assert.Equal(t, PermissionProjectAdmin, perms[0].Permission)
+}
+
+func TestListProjectGroupPermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/permissions/groups", req.URL.Path)
+		rw.Write([]byte(listGroupPermissionsResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	perms, _, err := client.Projects.ListProjectGroupPermissions(ctx, "PRJ", nil)
+	assert.NoError(t, err)
+	assert.Len(t, perms, 1)
+	assert.Equal(t, "developers", perms[0].Group)
+	assert.Equal(t, PermissionProjectWrite, perms[0].Permission)
+}
+
+func TestGrantProjectPermission(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/permissions/groups", req.URL.Path)
+		assert.Equal(t, []string{"developers", "testers"}, req.URL.Query()["name"])
+		assert.Equal(t, "PROJECT_WRITE", req.URL.Query().Get("permission"))
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.Projects.GrantProjectPermission(ctx, "PRJ", ProjectPermissionTypeGroup, PermissionProjectWrite, "developers", "testers")
+	assert.NoError(t, err)
+
+	_, err = client.Projects.GrantProjectPermission(ctx, "PRJ", "OTHER", PermissionProjectWrite, "developers")
+	assert.Error(t, err)
+	_, err = client.Projects.GrantProjectPermission(ctx, "PRJ", ProjectPermissionTypeGroup, PermissionProjectWrite)
+	assert.Error(t, err)
+}
+
+func TestRevokeProjectPermission(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/permissions/users", req.URL.Path)
+		assert.Equal(t, []string{"jdoe"}, req.URL.Query()["name"])
+		assert.False(t, req.URL.Query().Has("permission"))
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.Projects.RevokeProjectPermission(ctx, "PRJ", ProjectPermissionTypeUser, "jdoe")
+	assert.NoError(t, err)
+
+	_, err = client.Projects.RevokeProjectPermission(ctx, "PRJ", ProjectPermissionTypeUser, "")
+	assert.Error(t, err)
+}
+
+func TestProjectDefaultPermission(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/api/latest/projects/PRJ/permissions/PROJECT_READ/all", req.URL.Path)
+		switch req.Method {
+		case "GET":
+			rw.Write([]byte(`{"permitted":true}`))
+		case "POST":
+			assert.Equal(t, "false", req.URL.Query().Get("allow"))
+			rw.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	permitted, _, err := client.Projects.GetProjectDefaultPermission(ctx, "PRJ", PermissionProjectRead)
+	assert.NoError(t, err)
+	assert.True(t, permitted)
+	_, err = client.Projects.SetProjectDefaultPermission(ctx, "PRJ", PermissionProjectRead, false)
+	assert.NoError(t, err)
+}
+
+const listUserPermissionsResponse = `{
+  "size": 1,
+  "limit": 25,
+  "isLastPage": true,
+  "values": [
+    {
+      "user": {
+        "name": "jdoe",
+        "emailAddress": "jdoe@mymail.dk",
+        "active": true,
+        "displayName": "John Doe",
+        "id": 42,
+        "slug": "jdoe",
+        "type": "NORMAL"
+      },
+      "permission": "PROJECT_ADMIN"
+    }
+  ],
+  "start": 0
+}`
+
+const listGroupPermissionsResponse = `{
+  "size": 1,
+  "limit": 25,
+  "isLastPage": true,
+  "values": [
+    {
+      "group": {
+        "name": "developers"
+      },
+      "permission": "PROJECT_WRITE"
+    }
+  ],
+  "start": 0
+}`
@@ -4,6 +4,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"iter"
+	"mime/multipart"
+	"path"
 )
 
 type RepositoryList struct {
@@ -21,6 +25,7 @@ type Repository struct {
 	Archived    bool              `json:"archived,omitempty"`
 	State       *RepositoryState  `json:"state,omitempty"`
 	Project     *Project          `json:"project,omitempty"`
+	Origin      *Repository       `json:"origin,omitempty"`
 	Links       map[string][]Link `json:"links,omitempty"`
 }
 
@@ -29,6 +34,38 @@ type Link struct {
 	Name string `json:"name,omitempty"`
 }
 
+// RepositoryUpdate defines the changes to a repository, only fields set are changed.
+type RepositoryUpdate struct {
+	// Name is the new name of the repository, changing the name also changes the slug.
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Public      *bool   `json:"public,omitempty"`
+	Archived    *bool   `json:"archived,omitempty"`
+
+	// DefaultBranch is the new default branch, e.g. "refs/heads/main".
+	DefaultBranch string `json:"defaultBranch,omitempty"`
+
+	// Project moves the repository to the referenced project.
+	Project *ProjectRef `json:"project,omitempty"`
+}
+
+// ProjectRef references a project by key.
+type ProjectRef struct {
+	Key string `json:"key"`
+}
+
+// RepositoryFork defines the fork to create, forked into the personal project of the current user
+// if no project is set.
+type RepositoryFork struct {
+	Name    string      `json:"name,omitempty"`
+	Project *ProjectRef `json:"project,omitempty"`
+}
+
+// PersonalProjectKey returns the key of the personal project of the user, e.g. to fork into.
+func PersonalProjectKey(userSlug string) string {
+	return "~" + userSlug
+}
+
 type RepositoryState string
 
 const (
@@ -112,6 +149,13 @@ func (s *ProjectsService) SearchRepositories(ctx context.Context, opts *Reposito
 	return l.Repositories, resp, nil
 }
 
+func (s *ProjectsService) SearchRepositoriesIter(ctx context.Context, opts *RepositorySearchOptions) iter.Seq2[*Repository, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*Repository, *Response, error) {
+		return s.SearchRepositories(ctx, o)
+	})
+}
+
 func (s *ProjectsService) ListRepositories(ctx context.Context, projectKey string, opts *ListOptions) ([]*Repository, *Response, error) {
 	p := fmt.Sprintf("projects/%s/repos", projectKey)
 	var l RepositoryList
@@ -122,6 +166,13 @@ func (s *ProjectsService) ListRepositories(ctx context.Context, projectKey strin
 	return l.Repositories, resp, nil
 }
 
+func (s *ProjectsService) ListRepositoriesIter(ctx context.Context, projectKey string, opts *ListOptions) iter.Seq2[*Repository, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, o, func(ctx context.Context) ([]*Repository, *Response, error) {
+		return s.ListRepositories(ctx, projectKey, o)
+	})
+}
+
 func (s *ProjectsService) GetRepository(ctx context.Context, projectKey, repositorySlug string) (*Repository, *Response, error) {
 	p := fmt.Sprintf("projects/%s/repos/%s", projectKey, repositorySlug)
 	var r Repository
@@ -147,6 +198,22 @@ func (s *ProjectsService) CreateRepository(ctx context.Context, projectKey strin
 	return &r, resp, nil
 }
 
+// UpdateRepository updates the repository, e.g. to rename, archive or move it to another project.
+func (s *ProjectsService) UpdateRepository(ctx context.Context, projectKey, repositorySlug string, update *RepositoryUpdate) (*Repository, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s", projectKey, repositorySlug)
+	req, err := s.client.NewRequest("PUT", projectsApiName, p, update)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var r Repository
+	resp, err := s.client.Do(ctx, req, &r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r, resp, nil
+}
+
 func (s *ProjectsService) DeleteRepository(ctx context.Context, projectKey, repositorySlug string) (*Response, error) {
 	p := fmt.Sprintf("projects/%s/repos/%s", projectKey, repositorySlug)
 	req, err := s.client.NewRequest("DELETE", projectsApiName, p, nil)
@@ -156,6 +223,59 @@ func (s *ProjectsService) DeleteRepository(ctx context.Context, projectKey, repo
 	return s.client.Do(ctx, req, nil)
 }
 
+func (s *ProjectsService) ForkRepository(ctx context.Context, projectKey, repositorySlug string, fork *RepositoryFork) (*Repository, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s", projectKey, repositorySlug)
+	if fork == nil {
+		fork = &RepositoryFork{}
+	}
+	req, err := s.client.NewRequest("POST", projectsApiName, p, fork)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var r Repository
+	resp, err := s.client.Do(ctx, req, &r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &r, resp, nil
+}
+
+func (s *ProjectsService) ListForks(ctx context.Context, projectKey, repositorySlug string, opts *ListOptions) ([]*Repository, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/forks", projectKey, repositorySlug)
+	var l RepositoryList
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &l, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Repositories, resp, nil
+}
+
+func (s *ProjectsService) ListForksIter(ctx context.Context, projectKey, repositorySlug string, opts *ListOptions) iter.Seq2[*Repository, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, o, func(ctx context.Context) ([]*Repository, *Response, error) {
+		return s.ListForks(ctx, projectKey, repositorySlug, o)
+	})
+}
+
+// ListRelatedRepositories lists the repositories related to the repository, i.e. sharing the same origin.
+func (s *ProjectsService) ListRelatedRepositories(ctx context.Context, projectKey, repositorySlug string, opts *ListOptions) ([]*Repository, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/related", projectKey, repositorySlug)
+	var l RepositoryList
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &l, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Repositories, resp, nil
+}
+
+func (s *ProjectsService) ListRelatedRepositoriesIter(ctx context.Context, projectKey, repositorySlug string, opts *ListOptions) iter.Seq2[*Repository, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, o, func(ctx context.Context) ([]*Repository, *Response, error) {
+		return s.ListRelatedRepositories(ctx, projectKey, repositorySlug, o)
+	})
+}
+
 func (s *ProjectsService) ListFiles(ctx context.Context, projectKey, repositorySlug, path string, opts *FilesListOptions) ([]string, *Response, error) {
 	p := fmt.Sprintf("projects/%s/repos/%s/files/%s", projectKey, repositorySlug, path)
 
@@ -167,6 +287,132 @@ func (s *ProjectsService) ListFiles(ctx context.Context, projectKey, repositoryS
 	return l.Files, resp, nil
 }
 
+func (s *ProjectsService) ListFilesIter(ctx context.Context, projectKey, repositorySlug, path string, opts *FilesListOptions) iter.Seq2[string, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]string, *Response, error) {
+		return s.ListFiles(ctx, projectKey, repositorySlug, path, o)
+	})
+}
+
+// RawFile is the content of a file streamed from the repository, it must be closed after reading.
+type RawFile struct {
+	io.ReadCloser
+
+	// ContentType is the media type of the file as detected by Bitbucket, e.g. "image/png".
+	ContentType string
+
+	// Size is the size of the file in bytes, or -1 if unknown.
+	Size int64
+}
+
+type rawFileOptions struct {
+	At string `url:"at,omitempty"`
+}
+
+// GetRawFile streams the content of the file at the given ref, or the default branch if at is empty.
+// Unlike GetTextFileContent any type of file is supported and the content is returned unmodified.
+func (s *ProjectsService) GetRawFile(ctx context.Context, projectKey, repositorySlug, path, at string) (*RawFile, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/raw/%s", projectKey, repositorySlug, path)
+	req, err := s.client.NewRequest("GET", projectsApiName, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = addOptions(req, &rawFileOptions{At: at})
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "*/*")
+
+	resp, err := s.client.stream(ctx, req)
+	if err != nil {
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return nil, resp, err
+	}
+	f := &RawFile{
+		ReadCloser:  resp.Body,
+		ContentType: resp.Header.Get("Content-Type"),
+		Size:        resp.ContentLength,
+	}
+	return f, resp, nil
+}
+
+// FileEdit defines a change to a file committed on a branch.
+type FileEdit struct {
+	// Content is the new content of the file.
+	Content []byte
+
+	// Branch is the branch to commit to, it is created from SourceBranch if set.
+	Branch  string
+	Message string
+
+	// SourceCommitID is the commit the change is based on, required when updating an existing file.
+	// The change is rejected if the file was changed since, guarding against overwriting changes.
+	SourceCommitID string
+	SourceBranch   string
+}
+
+// EditFile creates or updates the file at filePath, committing the change to a branch. Creating a commit
+// is not idempotent, the request is thus only retried if RetryPolicy.RetryNonIdempotent is set.
+func (s *ProjectsService) EditFile(ctx context.Context, projectKey, repositorySlug, filePath string, edit *FileEdit) (*Commit, *Response, error) {
+	if edit == nil {
+		return nil, nil, fmt.Errorf("file edit must be set to edit file")
+	}
+	p := fmt.Sprintf("projects/%s/repos/%s/browse/%s", projectKey, repositorySlug, filePath)
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	fields := []struct{ name, value string }{
+		{"branch", edit.Branch},
+		{"message", edit.Message},
+		{"sourceCommitId", edit.SourceCommitID},
+		{"sourceBranch", edit.SourceBranch},
+	}
+	for _, f := range fields {
+		if f.value == "" {
+			continue
+		}
+		err := mw.WriteField(f.name, f.value)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	fw, err := mw.CreateFormFile("content", path.Base(filePath))
+	if err != nil {
+		return nil, nil, err
+	}
+	_, err = fw.Write(edit.Content)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = mw.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("PUT", projectsApiName, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	body := buf.Bytes()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.ContentLength = int64(len(body))
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	// Multipart requests are rejected by the XSRF protection of Bitbucket without this header.
+	req.Header.Set("X-Atlassian-Token", "no-check")
+
+	var c Commit
+	resp, err := s.client.Do(ctx, nonIdempotent(req), &c)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &c, resp, nil
+}
+
 type ErrOnlyTextFilesSupported struct{}
 
 func (e *ErrOnlyTextFilesSupported) Error() string {
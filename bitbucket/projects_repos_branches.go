@@ -3,8 +3,11 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
+const branchUtilsApiName = "branch-utils"
+
 type BranchList struct {
 	ListResponse
 
@@ -22,6 +25,23 @@ type Branch struct {
 
 type BranchType string
 
+type BranchCreate struct {
+	Name string `json:"name"`
+
+	// StartPoint is the commit ID or ref to create the branch from.
+	StartPoint string `json:"startPoint"`
+	Message    string `json:"message,omitempty"`
+}
+
+type branchDelete struct {
+	Name     string `json:"name"`
+	EndPoint string `json:"endPoint,omitempty"`
+}
+
+type branchRef struct {
+	ID string `json:"id"`
+}
+
 type BranchSearchOptions struct {
 	ListOptions
 
@@ -46,6 +66,13 @@ func (s *ProjectsService) SearchBranches(ctx context.Context, projectKey, reposi
 	return l.Branches, resp, nil
 }
 
+func (s *ProjectsService) SearchBranchesIter(ctx context.Context, projectKey, repositorySlug string, opts *BranchSearchOptions) iter.Seq2[*Branch, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*Branch, *Response, error) {
+		return s.SearchBranches(ctx, projectKey, repositorySlug, o)
+	})
+}
+
 func (s *ProjectsService) GetDefaultBranch(ctx context.Context, projectKey, repositorySlug string) (*Branch, *Response, error) {
 	p := fmt.Sprintf("projects/%s/repos/%s/branches/default", projectKey, repositorySlug)
 	var b Branch
@@ -55,3 +82,39 @@ func (s *ProjectsService) GetDefaultBranch(ctx context.Context, projectKey, repo
 	}
 	return &b, resp, nil
 }
+
+func (s *ProjectsService) CreateBranch(ctx context.Context, projectKey, repositorySlug string, branch *BranchCreate) (*Branch, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/branches", projectKey, repositorySlug)
+	req, err := s.client.NewRequest("POST", projectsApiName, p, branch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var b Branch
+	resp, err := s.client.Do(ctx, req, &b)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &b, resp, nil
+}
+
+// DeleteBranch deletes the branch, e.g. "refs/heads/feature/x". If endPoint is set the branch is only
+// deleted if it still points to the given commit ID.
+func (s *ProjectsService) DeleteBranch(ctx context.Context, projectKey, repositorySlug, name, endPoint string) (*Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/branches", projectKey, repositorySlug)
+	req, err := s.client.NewRequest("DELETE", branchUtilsApiName, p, &branchDelete{Name: name, EndPoint: endPoint})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// SetDefaultBranch sets the default branch of the repository, e.g. "refs/heads/main".
+func (s *ProjectsService) SetDefaultBranch(ctx context.Context, projectKey, repositorySlug, id string) (*Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/branches/default", projectKey, repositorySlug)
+	req, err := s.client.NewRequest("PUT", projectsApiName, p, &branchRef{ID: id})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
@@ -2,6 +2,7 @@ package bitbucket
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -49,6 +50,66 @@ func TestGetDefaultBranch(t *testing.T) {
 	assert.True(t, branch.Default)
 }
 
+func TestCreateBranch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/branches", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"name\":\"release/1.0\",\"startPoint\":\"refs/heads/main\"}\n", string(b))
+		rw.Write([]byte(createBranchResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	branch, _, err := client.Projects.CreateBranch(ctx, "PRJ", "repo", &BranchCreate{Name: "release/1.0", StartPoint: "refs/heads/main"})
+	assert.NoError(t, err)
+	assert.Equal(t, "refs/heads/release/1.0", branch.ID)
+	assert.Equal(t, "release/1.0", branch.DisplayID)
+	assert.False(t, branch.Default)
+}
+
+func TestDeleteBranch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/branch-utils/latest/projects/PRJ/repos/repo/branches", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"name\":\"refs/heads/release/1.0\",\"endPoint\":\"8d51122def5632836d1cb1026e879069e10a1e13\"}\n", string(b))
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.Projects.DeleteBranch(ctx, "PRJ", "repo", "refs/heads/release/1.0", "8d51122def5632836d1cb1026e879069e10a1e13")
+	assert.NoError(t, err)
+}
+
+func TestSetDefaultBranch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/branches/default", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"id\":\"refs/heads/main\"}\n", string(b))
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.Projects.SetDefaultBranch(ctx, "PRJ", "repo", "refs/heads/main")
+	assert.NoError(t, err)
+}
+
+const createBranchResponse = `{
+  "id": "refs/heads/release/1.0",
+  "displayId": "release/1.0",
+  "type": "BRANCH",
+  "latestCommit": "8d51122def5632836d1cb1026e879069e10a1e13",
+  "latestChangeset": "8d51122def5632836d1cb1026e879069e10a1e13",
+  "isDefault": false
+}`
+
 const searchBranchesResponse = `{
 	"size": 25,
 	"limit": 25,
@@ -3,6 +3,7 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 type GitUser struct {
@@ -128,6 +129,13 @@ func (s *ProjectsService) SearchCommits(ctx context.Context, projectKey, reposit
 	return l.Commits, resp, nil
 }
 
+func (s *ProjectsService) SearchCommitsIter(ctx context.Context, projectKey, repositorySlug string, opts *CommitSearchOptions) iter.Seq2[*Commit, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*Commit, *Response, error) {
+		return s.SearchCommits(ctx, projectKey, repositorySlug, o)
+	})
+}
+
 func (s *ProjectsService) GetCommit(ctx context.Context, projectKey, repositorySlug, commitId string) (*Commit, *Response, error) {
 	p := fmt.Sprintf("projects/%s/repos/%s/commits/%s", projectKey, repositorySlug, commitId)
 	var c Commit
@@ -163,6 +171,26 @@ func (s *ProjectsService) ListChanges(ctx context.Context, projectKey, repositor
 	return l.Changes, resp, nil
 }
 
+func (s *ProjectsService) ListChangesIter(ctx context.Context, projectKey, repositorySlug, commitId string, opts *ListOptions) iter.Seq2[*Change, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, o, func(ctx context.Context) ([]*Change, *Response, error) {
+		return s.ListChanges(ctx, projectKey, repositorySlug, commitId, o)
+	})
+}
+
+func (s *ProjectsService) GetCommitDiff(ctx context.Context, projectKey, repositorySlug, commitId string, opts *CommitDiffOptions) (*Diff, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/commits/%s/diff", projectKey, repositorySlug, commitId)
+	if opts != nil {
+		p = diffPath(p, opts.Path)
+	}
+	var d Diff
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &d, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &d, resp, nil
+}
+
 func (s *ProjectsService) CompareChanges(ctx context.Context, projectKey, repositorySlug string, opts *CompareChangesOptions) ([]*Change, *Response, error) {
 	p := fmt.Sprintf("projects/%s/repos/%s/compare/changes", projectKey, repositorySlug)
 	var l ChangeList
@@ -172,3 +200,23 @@ func (s *ProjectsService) CompareChanges(ctx context.Context, projectKey, reposi
 	}
 	return l.Changes, resp, nil
 }
+
+func (s *ProjectsService) CompareChangesIter(ctx context.Context, projectKey, repositorySlug string, opts *CompareChangesOptions) iter.Seq2[*Change, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*Change, *Response, error) {
+		return s.CompareChanges(ctx, projectKey, repositorySlug, o)
+	})
+}
+
+func (s *ProjectsService) CompareDiff(ctx context.Context, projectKey, repositorySlug string, opts *CompareDiffOptions) (*Diff, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/compare/diff", projectKey, repositorySlug)
+	if opts != nil {
+		p = diffPath(p, opts.Path)
+	}
+	var d Diff
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &d, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &d, resp, nil
+}
@@ -118,6 +118,47 @@ func TestCompareChanges(t *testing.T) {
 
 }
 
+func TestGetCommitDiff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/commits/9f8e7d6c5b4a39281706f5e4d3c2b1a098765432/diff", req.URL.Path)
+		assert.Equal(t, "0", req.URL.Query().Get("contextLines"))
+		rw.Write([]byte(getCommitDiffResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	diff, _, err := client.Projects.GetCommitDiff(ctx, "PRJ", "repo", "9f8e7d6c5b4a39281706f5e4d3c2b1a098765432", &CommitDiffOptions{
+		DiffOptions: DiffOptions{ContextLines: Ptr(0)},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, diff.Diffs, 4)
+	assert.Nil(t, diff.Diffs[1].Source)
+	assert.Nil(t, diff.Diffs[2].Destination)
+	assert.True(t, diff.Diffs[3].Binary)
+}
+
+func TestCompareDiff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/compare/diff/go.mod", req.URL.Path)
+		assert.Equal(t, "refs/heads/deps", req.URL.Query().Get("from"))
+		assert.Equal(t, "refs/heads/main", req.URL.Query().Get("to"))
+		rw.Write([]byte(getCommitDiffResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.CompareDiff(ctx, "PRJ", "repo", &CompareDiffOptions{
+		DiffOptions: DiffOptions{Path: "go.mod"},
+		From:        "refs/heads/deps",
+		To:          "refs/heads/main",
+	})
+	assert.NoError(t, err)
+}
+
 const searchCommitsResponse = `{
 	"values": [
 	  {
@@ -375,3 +416,71 @@ const compareChangesResponse = `{
         }
     ]
 }`
+
+const getCommitDiffResponse = `{
+  "fromHash": "0123456789abcdef0123456789abcdef01234567",
+  "toHash": "9f8e7d6c5b4a39281706f5e4d3c2b1a098765432",
+  "contextLines": 0,
+  "whitespace": "SHOW",
+  "diffs": [
+    {
+      "source": {"components": ["go.mod"], "parent": "", "name": "go.mod", "toString": "go.mod"},
+      "destination": {"components": ["go.mod"], "parent": "", "name": "go.mod", "toString": "go.mod"},
+      "hunks": [
+        {
+          "context": "module example.com/app",
+          "sourceLine": 5,
+          "sourceSpan": 2,
+          "destinationLine": 5,
+          "destinationSpan": 2,
+          "segments": [
+            {"type": "CONTEXT", "lines": [{"destination": 5, "source": 5, "line": "require (", "truncated": false}], "truncated": false},
+            {"type": "REMOVED", "lines": [{"destination": 6, "source": 6, "line": "\tgithub.com/stretchr/testify v1.8.4", "truncated": false}], "truncated": false},
+            {"type": "ADDED", "lines": [{"destination": 6, "source": 7, "line": "\tgithub.com/stretchr/testify v1.9.0", "truncated": false}], "truncated": false}
+          ],
+          "truncated": false
+        }
+      ],
+      "truncated": false
+    },
+    {
+      "destination": {"components": ["docs", "NOTES.md"], "parent": "docs", "name": "NOTES.md", "extension": "md", "toString": "docs/NOTES.md"},
+      "hunks": [
+        {
+          "sourceLine": 0,
+          "sourceSpan": 0,
+          "destinationLine": 1,
+          "destinationSpan": 1,
+          "segments": [
+            {"type": "ADDED", "lines": [{"destination": 1, "source": 0, "line": "# Notes", "truncated": false}], "truncated": false}
+          ],
+          "truncated": false
+        }
+      ],
+      "truncated": false
+    },
+    {
+      "source": {"components": ["old.txt"], "parent": "", "name": "old.txt", "extension": "txt", "toString": "old.txt"},
+      "hunks": [
+        {
+          "sourceLine": 1,
+          "sourceSpan": 1,
+          "destinationLine": 0,
+          "destinationSpan": 0,
+          "segments": [
+            {"type": "REMOVED", "lines": [{"destination": 0, "source": 1, "line": "obsolete", "truncated": false}], "truncated": false}
+          ],
+          "truncated": false
+        }
+      ],
+      "truncated": false
+    },
+    {
+      "source": {"components": ["logo.png"], "parent": "", "name": "logo.png", "extension": "png", "toString": "logo.png"},
+      "destination": {"components": ["logo.png"], "parent": "", "name": "logo.png", "extension": "png", "toString": "logo.png"},
+      "binary": true,
+      "truncated": false
+    }
+  ],
+  "truncated": false
+}`
@@ -0,0 +1,256 @@
+package bitbucket
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Errors returned when rendering a diff which cannot be applied.
+var (
+	ErrDiffTruncated = errors.New("bitbucket: diff is truncated")
+	ErrDiffBinary    = errors.New("bitbucket: diff contains binary files")
+)
+
+// Diff is a line level diff between two commits, split into a FileDiff per changed file.
+type Diff struct {
+	FromHash     string      `json:"fromHash"`
+	ToHash       string      `json:"toHash"`
+	ContextLines int         `json:"contextLines"`
+	Whitespace   string      `json:"whitespace"`
+	Diffs        []*FileDiff `json:"diffs"`
+	Truncated    bool        `json:"truncated"`
+}
+
+// FileDiff is the diff of a single file. Source is nil for added files and Destination is nil for
+// deleted files.
+type FileDiff struct {
+	Source      *ChangePath `json:"source"`
+	Destination *ChangePath `json:"destination"`
+	Hunks       []*DiffHunk `json:"hunks"`
+	Binary      bool        `json:"binary"`
+	Truncated   bool        `json:"truncated"`
+}
+
+// DiffHunk is a contiguous range of changed lines with surrounding context lines.
+type DiffHunk struct {
+	// Context is the text of the line preceding the hunk, e.g. a function declaration.
+	Context         string         `json:"context,omitempty"`
+	SourceLine      int            `json:"sourceLine"`
+	SourceSpan      int            `json:"sourceSpan"`
+	DestinationLine int            `json:"destinationLine"`
+	DestinationSpan int            `json:"destinationSpan"`
+	Segments        []*DiffSegment `json:"segments"`
+	Truncated       bool           `json:"truncated"`
+}
+
+// DiffSegment is a run of lines of the same type within a hunk.
+type DiffSegment struct {
+	Type      DiffSegmentType `json:"type"`
+	Lines     []*DiffLine     `json:"lines"`
+	Truncated bool            `json:"truncated"`
+}
+
+type DiffSegmentType string
+
+const (
+	DiffSegmentTypeAdded   DiffSegmentType = "ADDED"
+	DiffSegmentTypeRemoved DiffSegmentType = "REMOVED"
+	DiffSegmentTypeContext DiffSegmentType = "CONTEXT"
+)
+
+// DiffLine is a line of a segment with the line numbers in the source and destination file. Only the
+// line number of the side the line is present on is meaningful for added and removed lines.
+type DiffLine struct {
+	Source      int    `json:"source"`
+	Destination int    `json:"destination"`
+	Line        string `json:"line"`
+	Truncated   bool   `json:"truncated"`
+
+	// ConflictMarker is set for lines of a merge conflict in a pull request diff.
+	ConflictMarker DiffConflictMarker `json:"conflictMarker,omitempty"`
+
+	// CommentIDs lists the comments anchored to the line, only set for pull request diffs with comments.
+	CommentIDs []uint64 `json:"commentIds,omitempty"`
+}
+
+type DiffConflictMarker string
+
+const (
+	DiffConflictMarkerMarker DiffConflictMarker = "MARKER"
+	DiffConflictMarkerOurs   DiffConflictMarker = "OURS"
+	DiffConflictMarkerTheirs DiffConflictMarker = "THEIRS"
+)
+
+// DiffOptions controls how the diff is computed.
+type DiffOptions struct {
+	// Path limits the diff to a single file.
+	Path string `url:"-"`
+
+	// SrcPath is the previous path of the file if it was renamed, only used with Path.
+	SrcPath string `url:"srcPath,omitempty"`
+
+	// ContextLines is the number of context lines around changes, the server default if nil.
+	ContextLines *int `url:"contextLines,omitempty"`
+
+	Whitespace DiffWhitespace `url:"whitespace,omitempty"`
+}
+
+type DiffWhitespace string
+
+const (
+	DiffWhitespaceShow      DiffWhitespace = "show"
+	DiffWhitespaceIgnoreAll DiffWhitespace = "ignore-all"
+)
+
+// diffPath returns the path of the diff resource, limited to the file at path if set.
+func diffPath(p, path string) string {
+	if path == "" {
+		return p
+	}
+	return p + "/" + path
+}
+
+// CommitDiffOptions controls the diff of a commit. The diff is against the first parent of the commit
+// unless Since is set.
+type CommitDiffOptions struct {
+	DiffOptions
+
+	Since        string `url:"since,omitempty"`
+	WithComments *bool  `url:"withComments,omitempty"`
+}
+
+// CompareDiffOptions controls the diff between the From and To commits or refs. FromRepo is the
+// repository of From if it is a fork, e.g. "~USER/repo".
+type CompareDiffOptions struct {
+	DiffOptions
+
+	From     string `url:"from,omitempty"`
+	To       string `url:"to,omitempty"`
+	FromRepo string `url:"fromRepo,omitempty"`
+}
+
+// IsTruncated reports whether any part of the diff was truncated by the server.
+func (d *Diff) IsTruncated() bool {
+	if d.Truncated {
+		return true
+	}
+	for _, fd := range d.Diffs {
+		if fd.IsTruncated() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTruncated reports whether any hunk, segment or line of the file diff was truncated by the server.
+func (d *FileDiff) IsTruncated() bool {
+	if d.Truncated {
+		return true
+	}
+	for _, h := range d.Hunks {
+		if h.Truncated {
+			return true
+		}
+		for _, sg := range h.Segments {
+			if sg.Truncated {
+				return true
+			}
+			for _, l := range sg.Lines {
+				if l.Truncated {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// WriteUnified renders the diff in the unified diff format of git, which can be applied with git apply.
+// ErrDiffTruncated or ErrDiffBinary is returned without writing anything if the diff is truncated or
+// contains binary files, as the content of those is not part of the diff.
+//
+// The structured diff does not include file modes or whether a file ends with a newline. New and
+// deleted files are rendered without a git header and mode, and "\ No newline at end of file" markers
+// are never written, so changes to only the mode or the trailing newline of a file, as well as empty
+// new or deleted files, are not reproduced.
+func (d *Diff) WriteUnified(w io.Writer) error {
+	if d.IsTruncated() {
+		return ErrDiffTruncated
+	}
+	for _, fd := range d.Diffs {
+		if fd.Binary {
+			return ErrDiffBinary
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, fd := range d.Diffs {
+		fd.writeUnified(bw)
+	}
+	return bw.Flush()
+}
+
+// Unified returns the diff in the unified diff format of git, see WriteUnified.
+func (d *Diff) Unified() (string, error) {
+	var sb strings.Builder
+	err := d.WriteUnified(&sb)
+	if err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+func (d *FileDiff) writeUnified(w *bufio.Writer) {
+	src, dst := "/dev/null", "/dev/null"
+	if d.Source != nil {
+		src = "a/" + d.Source.Title
+	}
+	if d.Destination != nil {
+		dst = "b/" + d.Destination.Title
+	}
+
+	// A git header of a new or deleted file requires the mode, which is unknown. Those files are
+	// written without a git header, git apply detects them from /dev/null in the file headers. A git
+	// header without hunks is only valid for a rename, other changes without hunks (e.g. mode or
+	// ignored whitespace changes) are left out.
+	renamed := d.Source != nil && d.Destination != nil && d.Source.Title != d.Destination.Title
+	if d.Source != nil && d.Destination != nil && (renamed || len(d.Hunks) > 0) {
+		fmt.Fprintf(w, "diff --git %s %s\n", src, dst)
+		if renamed {
+			fmt.Fprintf(w, "rename from %s\n", d.Source.Title)
+			fmt.Fprintf(w, "rename to %s\n", d.Destination.Title)
+		}
+	}
+
+	if len(d.Hunks) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "--- %s\n", src)
+	fmt.Fprintf(w, "+++ %s\n", dst)
+	for _, h := range d.Hunks {
+		fmt.Fprintf(w, "@@ -%d,%d +%d,%d @@", h.SourceLine, h.SourceSpan, h.DestinationLine, h.DestinationSpan)
+		if h.Context != "" {
+			fmt.Fprintf(w, " %s", h.Context)
+		}
+		w.WriteString("\n")
+
+		for _, sg := range h.Segments {
+			prefix := " "
+			switch sg.Type {
+			case DiffSegmentTypeAdded:
+				prefix = "+"
+			case DiffSegmentTypeRemoved:
+				prefix = "-"
+			}
+			for _, l := range sg.Lines {
+				w.WriteString(prefix)
+				w.WriteString(l.Line)
+				w.WriteString("\n")
+			}
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffUnified(t *testing.T) {
+	var d Diff
+	err := json.Unmarshal([]byte(getCommitDiffResponse), &d)
+	assert.NoError(t, err)
+	assert.False(t, d.IsTruncated())
+	// Drop the binary file which cannot be rendered.
+	d.Diffs = d.Diffs[:3]
+
+	out, err := d.Unified()
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"diff --git a/go.mod b/go.mod",
+		"--- a/go.mod",
+		"+++ b/go.mod",
+		"@@ -5,2 +5,2 @@ module example.com/app",
+		" require (",
+		"-\tgithub.com/stretchr/testify v1.8.4",
+		"+\tgithub.com/stretchr/testify v1.9.0",
+		"--- /dev/null",
+		"+++ b/docs/NOTES.md",
+		"@@ -0,0 +1,1 @@",
+		"+# Notes",
+		"--- a/old.txt",
+		"+++ /dev/null",
+		"@@ -1,1 +0,0 @@",
+		"-obsolete",
+		"",
+	}, "\n"), out)
+}
+
+func TestDiffUnifiedBinary(t *testing.T) {
+	var d Diff
+	err := json.Unmarshal([]byte(getCommitDiffResponse), &d)
+	assert.NoError(t, err)
+
+	var sb strings.Builder
+	err = d.WriteUnified(&sb)
+	assert.ErrorIs(t, err, ErrDiffBinary)
+	assert.Empty(t, sb.String())
+}
+
+func TestDiffUnifiedWithoutHunks(t *testing.T) {
+	d := &Diff{Diffs: []*FileDiff{{
+		// Mode change only, there is nothing to apply.
+		Source:      &ChangePath{Title: "run.sh"},
+		Destination: &ChangePath{Title: "run.sh"},
+	}, {
+		Source:      &ChangePath{Title: "a.txt"},
+		Destination: &ChangePath{Title: "b.txt"},
+	}, {
+		Source:      &ChangePath{Title: "c.txt"},
+		Destination: &ChangePath{Title: "c.txt"},
+		Hunks: []*DiffHunk{{
+			SourceLine: 1, SourceSpan: 1, DestinationLine: 1, DestinationSpan: 1,
+			Segments: []*DiffSegment{
+				{Type: DiffSegmentTypeRemoved, Lines: []*DiffLine{{Line: "old"}}},
+				{Type: DiffSegmentTypeAdded, Lines: []*DiffLine{{Line: "new"}}},
+			},
+		}},
+	}}}
+
+	out, err := d.Unified()
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"diff --git a/a.txt b/b.txt",
+		"rename from a.txt",
+		"rename to b.txt",
+		"diff --git a/c.txt b/c.txt",
+		"--- a/c.txt",
+		"+++ b/c.txt",
+		"@@ -1,1 +1,1 @@",
+		"-old",
+		"+new",
+		"",
+	}, "\n"), out)
+}
+
+func TestDiffUnifiedTruncated(t *testing.T) {
+	d := &Diff{Diffs: []*FileDiff{{
+		Source:      &ChangePath{Title: "big.txt"},
+		Destination: &ChangePath{Title: "big.txt"},
+		Hunks: []*DiffHunk{{Segments: []*DiffSegment{{
+			Type:  DiffSegmentTypeAdded,
+			Lines: []*DiffLine{{Line: "x", Truncated: true}},
+		}}}},
+	}}}
+	assert.True(t, d.IsTruncated())
+	_, err := d.Unified()
+	assert.ErrorIs(t, err, ErrDiffTruncated)
+}
+
+func TestDiffConflictMarker(t *testing.T) {
+	var l DiffLine
+	err := json.Unmarshal([]byte(`{"source":3,"destination":3,"line":"<<<<<<< MINE","conflictMarker":"MARKER"}`), &l)
+	assert.NoError(t, err)
+	assert.Equal(t, DiffConflictMarkerMarker, l.ConflictMarker)
+}
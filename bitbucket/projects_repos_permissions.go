@@ -0,0 +1,91 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// SearchRepositoryPermissions searches the permissions granted directly on the repository. The permissions
+// use the same representation as project permissions, e.g. PermissionRepoWrite.
+func (s *ProjectsService) SearchRepositoryPermissions(ctx context.Context, projectKey, repositorySlug string, opts *ProjectPermissionSearchOptions) ([]*ProjectPermission, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/permissions/search", projectKey, repositorySlug)
+	var l ProjectPermissionList
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &l, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Permissions, resp, nil
+}
+
+func (s *ProjectsService) SearchRepositoryPermissionsIter(ctx context.Context, projectKey, repositorySlug string, opts *ProjectPermissionSearchOptions) iter.Seq2[*ProjectPermission, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*ProjectPermission, *Response, error) {
+		return s.SearchRepositoryPermissions(ctx, projectKey, repositorySlug, o)
+	})
+}
+
+func (s *ProjectsService) ListRepositoryUserPermissions(ctx context.Context, projectKey, repositorySlug string, opts *PermissionListOptions) ([]*ProjectPermission, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/permissions/users", projectKey, repositorySlug)
+	var l UserPermissionList
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &l, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return newUserPermissions(l.Permissions), resp, nil
+}
+
+func (s *ProjectsService) ListRepositoryUserPermissionsIter(ctx context.Context, projectKey, repositorySlug string, opts *PermissionListOptions) iter.Seq2[*ProjectPermission, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*ProjectPermission, *Response, error) {
+		return s.ListRepositoryUserPermissions(ctx, projectKey, repositorySlug, o)
+	})
+}
+
+func (s *ProjectsService) ListRepositoryGroupPermissions(ctx context.Context, projectKey, repositorySlug string, opts *PermissionListOptions) ([]*ProjectPermission, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/permissions/groups", projectKey, repositorySlug)
+	var l GroupPermissionList
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &l, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return newGroupPermissions(l.Permissions), resp, nil
+}
+
+func (s *ProjectsService) ListRepositoryGroupPermissionsIter(ctx context.Context, projectKey, repositorySlug string, opts *PermissionListOptions) iter.Seq2[*ProjectPermission, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*ProjectPermission, *Response, error) {
+		return s.ListRepositoryGroupPermissions(ctx, projectKey, repositorySlug, o)
+	})
+}
+
+// GrantRepositoryPermission grants the permission, e.g. PermissionRepoRead, to the named users or groups
+// depending on the permission type.
+func (s *ProjectsService) GrantRepositoryPermission(ctx context.Context, projectKey, repositorySlug string, permissionType ProjectPermissionType, permission Permission, names ...string) (*Response, error) {
+	if len(names) == 0 {
+		return nil, fmt.Errorf("names must be set to grant permission")
+	}
+	p, err := permissionPath(fmt.Sprintf("projects/%s/repos/%s", projectKey, repositorySlug), permissionType)
+	if err != nil {
+		return nil, err
+	}
+	return s.updatePermissions(ctx, "PUT", p, &permissionOptions{Names: names, Permission: permission})
+}
+
+// RevokeRepositoryPermission revokes all repository permissions from the named user or group depending
+// on the permission type.
+func (s *ProjectsService) RevokeRepositoryPermission(ctx context.Context, projectKey, repositorySlug string, permissionType ProjectPermissionType, name string) (*Response, error) {
+	if name == "" {
+		return nil, fmt.Errorf("name must be set to revoke permission")
+	}
+	p, err := permissionPath(fmt.Sprintf("projects/%s/repos/%s", projectKey, repositorySlug), permissionType)
+	if err != nil {
+		return nil, err
+	}
+	return s.updatePermissions(ctx, "DELETE", p, &permissionOptions{Names: []string{name}})
+}
+
+// SetRepositoryPublic enables or disables public (anonymous) read access to the repository.
+func (s *ProjectsService) SetRepositoryPublic(ctx context.Context, projectKey, repositorySlug string, public bool) (*Repository, *Response, error) {
+	return s.UpdateRepository(ctx, projectKey, repositorySlug, &RepositoryUpdate{Public: Ptr(public)})
+}
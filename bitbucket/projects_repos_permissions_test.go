@@ -0,0 +1,113 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchRepositoryPermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/permissions/search", req.URL.Path)
+		assert.Equal(t, "GROUP", req.URL.Query().Get("type"))
+		rw.Write([]byte(searchProjectPermissionsResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	perms, _, err := client.Projects.SearchRepositoryPermissions(ctx, "PRJ", "repo", &ProjectPermissionSearchOptions{Type: ProjectPermissionTypeGroup})
+	assert.NoError(t, err)
+	assert.Len(t, perms, 8)
+}
+
+func TestListRepositoryUserPermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/permissions/users", req.URL.Path)
+		rw.Write([]byte(listUserPermissionsResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	perms, _, err := client.Projects.ListRepositoryUserPermissions(ctx, "PRJ", "repo", nil)
+	assert.NoError(t, err)
+	assert.Len(t, perms, 1)
+	assert.Equal(t, "jdoe", perms[0].User.Name)
+}
+
+func TestListRepositoryGroupPermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/permissions/groups", req.URL.Path)
+		rw.Write([]byte(listGroupPermissionsResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	perms, _, err := client.Projects.ListRepositoryGroupPermissions(ctx, "PRJ", "repo", nil)
+	assert.NoError(t, err)
+	assert.Len(t, perms, 1)
+	assert.Equal(t, "developers", perms[0].Group)
+}
+
+func TestGrantRepositoryPermission(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/permissions/users", req.URL.Path)
+		assert.Equal(t, "jdoe", req.URL.Query().Get("name"))
+		assert.Equal(t, "REPO_WRITE", req.URL.Query().Get("permission"))
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.Projects.GrantRepositoryPermission(ctx, "PRJ", "repo", ProjectPermissionTypeUser, PermissionRepoWrite, "jdoe")
+	assert.NoError(t, err)
+
+	_, err = client.Projects.GrantRepositoryPermission(ctx, "PRJ", "repo", ProjectPermissionTypeUser, PermissionRepoWrite)
+	assert.Error(t, err)
+}
+
+func TestRevokeRepositoryPermission(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/permissions/groups", req.URL.Path)
+		assert.Equal(t, []string{"developers"}, req.URL.Query()["name"])
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.Projects.RevokeRepositoryPermission(ctx, "PRJ", "repo", ProjectPermissionTypeGroup, "developers")
+	assert.NoError(t, err)
+
+	_, err = client.Projects.RevokeRepositoryPermission(ctx, "PRJ", "repo", ProjectPermissionTypeGroup, "")
+	assert.Error(t, err)
+}
+
+func TestSetRepositoryPublic(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"public\":false}\n", string(b))
+		rw.Write([]byte(getProjectsRepositoryResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	repo, _, err := client.Projects.SetRepositoryPublic(ctx, "PRJ", "repo", false)
+	assert.NoError(t, err)
+	assert.False(t, repo.Public)
+}
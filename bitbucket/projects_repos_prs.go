@@ -3,12 +3,14 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 type PullRequest struct {
 	ID           uint64                   `json:"id,omitempty"`
 	Version      uint64                   `json:"version,omitempty"`
 	Title        string                   `json:"title"`
+	Description  string                   `json:"description,omitempty"`
 	State        PullRequestState         `json:"state"`
 	Open         bool                     `json:"open"`
 	Closed       bool                     `json:"closed"`
@@ -61,6 +63,141 @@ const (
 	PullRequestAuthorStatusNeedsWork  PullRequestAuthorStatus = "NEEDS_WORK"
 )
 
+// PullRequestMergeStrategy identifies a merge strategy, only strategies enabled for the repository can be used.
+type PullRequestMergeStrategy string
+
+const (
+	PullRequestMergeStrategyNoFastForward       PullRequestMergeStrategy = "no-ff"
+	PullRequestMergeStrategyFastForward         PullRequestMergeStrategy = "ff"
+	PullRequestMergeStrategyFastForwardOnly     PullRequestMergeStrategy = "ff-only"
+	PullRequestMergeStrategySquash              PullRequestMergeStrategy = "squash"
+	PullRequestMergeStrategySquashFastForward   PullRequestMergeStrategy = "squash-ff-only"
+	PullRequestMergeStrategyRebaseNoFastForward PullRequestMergeStrategy = "rebase-no-ff"
+	PullRequestMergeStrategyRebaseFastForward   PullRequestMergeStrategy = "rebase-ff-only"
+)
+
+// PullRequestCreate defines the pull request to create from the source ref to the target ref.
+type PullRequestCreate struct {
+	Title       string
+	Description string
+	Source      PullRequestRefSpec
+	Target      PullRequestRefSpec
+
+	// Reviewers lists the names of the users to add as reviewers.
+	Reviewers []string
+}
+
+// PullRequestUpdate defines the changes to a pull request. Version must be the current version of the
+// pull request, an outdated version is rejected with ExceptionPullRequestOutOfDate. Title and
+// Description are only changed if set, Reviewers replaces the reviewers unless nil and Target retargets
+// the pull request if set.
+type PullRequestUpdate struct {
+	Version     uint64
+	Title       *string
+	Description *string
+	Reviewers   []string
+	Target      *PullRequestRefSpec
+}
+
+// PullRequestRefSpec references a ref, e.g. "refs/heads/main", in a repository.
+type PullRequestRefSpec struct {
+	ID         string        `json:"id"`
+	Repository RepositoryRef `json:"repository"`
+}
+
+// RepositoryRef references a repository by slug and project key.
+type RepositoryRef struct {
+	Slug    string     `json:"slug"`
+	Project ProjectRef `json:"project"`
+}
+
+// NewPullRequestRefSpec returns a reference to the ref in the repository.
+func NewPullRequestRefSpec(projectKey, repositorySlug, id string) PullRequestRefSpec {
+	return PullRequestRefSpec{ID: id, Repository: RepositoryRef{Slug: repositorySlug, Project: ProjectRef{Key: projectKey}}}
+}
+
+// PullRequestMergeOptions defines how a pull request is merged. The default merge strategy and
+// commit message of the repository are used if not set.
+type PullRequestMergeOptions struct {
+	Strategy PullRequestMergeStrategy
+	Message  string
+}
+
+// PullRequestMergeStatus is the result of evaluating the merge checks of a pull request.
+type PullRequestMergeStatus struct {
+	CanMerge   bool                    `json:"canMerge"`
+	Conflicted bool                    `json:"conflicted"`
+	Outcome    PullRequestMergeOutcome `json:"outcome"`
+	Vetoes     []PullRequestMergeVeto  `json:"vetoes"`
+}
+
+// PullRequestMergeVeto is a reason reported by a merge check preventing the pull request from being merged,
+// e.g. missing approvals or failed builds.
+type PullRequestMergeVeto struct {
+	Summary  string `json:"summaryMessage"`
+	Detailed string `json:"detailedMessage"`
+}
+
+type PullRequestMergeOutcome string
+
+const (
+	PullRequestMergeOutcomeClean      PullRequestMergeOutcome = "CLEAN"
+	PullRequestMergeOutcomeConflicted PullRequestMergeOutcome = "CONFLICTED"
+	PullRequestMergeOutcomeUnknown    PullRequestMergeOutcome = "UNKNOWN"
+)
+
+// PullRequestDiffOptions controls the diff of a pull request. SinceID and UntilID limit the diff to the
+// changes between two commits of the pull request, e.g. to review only commits added since the last review.
+type PullRequestDiffOptions struct {
+	DiffOptions
+
+	SinceID      string `url:"sinceId,omitempty"`
+	UntilID      string `url:"untilId,omitempty"`
+	WithComments *bool  `url:"withComments,omitempty"`
+}
+
+type pullRequestRequest struct {
+	Version     *uint64             `json:"version,omitempty"`
+	Title       *string             `json:"title,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Source      *PullRequestRefSpec `json:"fromRef,omitempty"`
+	Target      *PullRequestRefSpec `json:"toRef,omitempty"`
+	Reviewers   *[]reviewerRef      `json:"reviewers,omitempty"`
+}
+
+type pullRequestMergeRequest struct {
+	Version  uint64                   `json:"version"`
+	Strategy PullRequestMergeStrategy `json:"strategyId,omitempty"`
+	Message  string                   `json:"message,omitempty"`
+}
+
+type reviewerRef struct {
+	User userRef `json:"user"`
+}
+
+type userRef struct {
+	Name string `json:"name"`
+}
+
+type versionOptions struct {
+	Version uint64 `url:"version"`
+}
+
+type versionRequest struct {
+	Version uint64 `json:"version"`
+}
+
+func newReviewerRefs(names []string) *[]reviewerRef {
+	if names == nil {
+		return nil
+	}
+	reviewers := make([]reviewerRef, len(names))
+	for i, n := range names {
+		reviewers[i] = reviewerRef{User: userRef{Name: n}}
+	}
+	return &reviewers
+}
+
 type PullRequestList struct {
 	ListResponse
 
@@ -85,6 +222,13 @@ func (s *ProjectsService) SearchPullRequests(ctx context.Context, projectKey, re
 	return l.PullRequests, resp, nil
 }
 
+func (s *ProjectsService) SearchPullRequestsIter(ctx context.Context, projectKey, repositorySlug string, opts *PullRequestSearchOptions) iter.Seq2[*PullRequest, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*PullRequest, *Response, error) {
+		return s.SearchPullRequests(ctx, projectKey, repositorySlug, o)
+	})
+}
+
 func (s *ProjectsService) GetPullRequest(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64) (*PullRequest, *Response, error) {
 	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d", projectKey, repositorySlug, pullRequestId)
 	var pr PullRequest
@@ -104,3 +248,132 @@ func (s *ProjectsService) ListPullRequestChanges(ctx context.Context, projectKey
 	}
 	return l.Changes, resp, nil
 }
+
+func (s *ProjectsService) ListPullRequestChangesIter(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, opts *ListOptions) iter.Seq2[*Change, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, o, func(ctx context.Context) ([]*Change, *Response, error) {
+		return s.ListPullRequestChanges(ctx, projectKey, repositorySlug, pullRequestId, o)
+	})
+}
+
+func (s *ProjectsService) ListPullRequestCommits(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, opts *ListOptions) ([]*Commit, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/commits", projectKey, repositorySlug, pullRequestId)
+	var l CommitList
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &l, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Commits, resp, nil
+}
+
+func (s *ProjectsService) ListPullRequestCommitsIter(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, opts *ListOptions) iter.Seq2[*Commit, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, o, func(ctx context.Context) ([]*Commit, *Response, error) {
+		return s.ListPullRequestCommits(ctx, projectKey, repositorySlug, pullRequestId, o)
+	})
+}
+
+func (s *ProjectsService) GetPullRequestDiff(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, opts *PullRequestDiffOptions) (*Diff, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/diff", projectKey, repositorySlug, pullRequestId)
+	if opts != nil {
+		p = diffPath(p, opts.Path)
+	}
+	var d Diff
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &d, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &d, resp, nil
+}
+
+func (s *ProjectsService) CreatePullRequest(ctx context.Context, projectKey, repositorySlug string, pr *PullRequestCreate) (*PullRequest, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests", projectKey, repositorySlug)
+	body := &pullRequestRequest{
+		Title:     &pr.Title,
+		Source:    &pr.Source,
+		Target:    &pr.Target,
+		Reviewers: newReviewerRefs(pr.Reviewers),
+	}
+	if pr.Description != "" {
+		body.Description = &pr.Description
+	}
+	return s.sendPullRequest(ctx, "POST", p, body, nil)
+}
+
+func (s *ProjectsService) UpdatePullRequest(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, update *PullRequestUpdate) (*PullRequest, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d", projectKey, repositorySlug, pullRequestId)
+	body := &pullRequestRequest{
+		Version:     &update.Version,
+		Title:       update.Title,
+		Description: update.Description,
+		Target:      update.Target,
+		Reviewers:   newReviewerRefs(update.Reviewers),
+	}
+	return s.sendPullRequest(ctx, "PUT", p, body, nil)
+}
+
+// CanMergePullRequest evaluates whether the pull request can be merged, returning the vetoes of the merge
+// checks preventing it otherwise.
+func (s *ProjectsService) CanMergePullRequest(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64) (*PullRequestMergeStatus, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/merge", projectKey, repositorySlug, pullRequestId)
+	var m PullRequestMergeStatus
+	resp, err := s.client.Get(ctx, projectsApiName, p, &m)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &m, resp, nil
+}
+
+// MergePullRequest merges the pull request at the given version. If opts is nil the repository defaults are used.
+func (s *ProjectsService) MergePullRequest(ctx context.Context, projectKey, repositorySlug string, pullRequestId, version uint64, opts *PullRequestMergeOptions) (*PullRequest, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/merge", projectKey, repositorySlug, pullRequestId)
+	body := &pullRequestMergeRequest{Version: version}
+	if opts != nil {
+		body.Strategy = opts.Strategy
+		body.Message = opts.Message
+	}
+	return s.sendPullRequest(ctx, "POST", p, body, &versionOptions{Version: version})
+}
+
+func (s *ProjectsService) DeclinePullRequest(ctx context.Context, projectKey, repositorySlug string, pullRequestId, version uint64) (*PullRequest, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/decline", projectKey, repositorySlug, pullRequestId)
+	return s.sendPullRequest(ctx, "POST", p, &versionRequest{Version: version}, &versionOptions{Version: version})
+}
+
+func (s *ProjectsService) ReopenPullRequest(ctx context.Context, projectKey, repositorySlug string, pullRequestId, version uint64) (*PullRequest, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/reopen", projectKey, repositorySlug, pullRequestId)
+	return s.sendPullRequest(ctx, "POST", p, &versionRequest{Version: version}, &versionOptions{Version: version})
+}
+
+func (s *ProjectsService) DeletePullRequest(ctx context.Context, projectKey, repositorySlug string, pullRequestId, version uint64) (*Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d", projectKey, repositorySlug, pullRequestId)
+	req, err := s.client.NewRequest("DELETE", projectsApiName, p, &versionRequest{Version: version})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// sendPullRequest sends the request body to the pull request path. The version is also passed as
+// query parameter via opts for actions where older Bitbucket versions only read it from there.
+func (s *ProjectsService) sendPullRequest(ctx context.Context, method, p string, body, opts interface{}) (*PullRequest, *Response, error) {
+	req, err := s.client.NewRequest(method, projectsApiName, p, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = addOptions(req, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if method == "PUT" {
+		// Updates are versioned, sending an update again after it succeeded fails with a conflict.
+		req = nonIdempotent(req)
+	}
+
+	var pr PullRequest
+	resp, err := s.client.Do(ctx, req, &pr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &pr, resp, nil
+}
@@ -0,0 +1,184 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+)
+
+// PullRequestActivity is an activity in the history of a pull request. The concrete type depends on the
+// action, e.g. *PullRequestCommentActivity for ActivityActionCommented. Activities with actions not
+// known by this package are returned as *PullRequestActivityInfo.
+type PullRequestActivity interface {
+	Info() *PullRequestActivityInfo
+}
+
+// PullRequestActivityInfo holds the fields common to all activities.
+type PullRequestActivityInfo struct {
+	ID      uint64         `json:"id"`
+	Created *DateTime      `json:"createdDate"`
+	User    User           `json:"user"`
+	Action  ActivityAction `json:"action"`
+}
+
+func (a *PullRequestActivityInfo) Info() *PullRequestActivityInfo {
+	return a
+}
+
+type ActivityAction string
+
+const (
+	ActivityActionApproved         ActivityAction = "APPROVED"
+	ActivityActionCommented        ActivityAction = "COMMENTED"
+	ActivityActionDeclined         ActivityAction = "DECLINED"
+	ActivityActionDeleted          ActivityAction = "DELETED"
+	ActivityActionMerged           ActivityAction = "MERGED"
+	ActivityActionOpened           ActivityAction = "OPENED"
+	ActivityActionReopened         ActivityAction = "REOPENED"
+	ActivityActionRescoped         ActivityAction = "RESCOPED"
+	ActivityActionReviewed         ActivityAction = "REVIEWED"
+	ActivityActionReviewersUpdated ActivityAction = "REVIEWERS_UPDATED"
+	ActivityActionUnapproved       ActivityAction = "UNAPPROVED"
+	ActivityActionUpdated          ActivityAction = "UPDATED"
+)
+
+type CommentAction string
+
+const (
+	CommentActionAdded   CommentAction = "ADDED"
+	CommentActionDeleted CommentAction = "DELETED"
+	CommentActionEdited  CommentAction = "EDITED"
+	CommentActionReplied CommentAction = "REPLIED"
+)
+
+// PullRequestCommentActivity is a comment added, edited, deleted or replied to.
+type PullRequestCommentActivity struct {
+	PullRequestActivityInfo
+
+	CommentAction CommentAction  `json:"commentAction"`
+	Comment       *Comment       `json:"comment"`
+	CommentAnchor *CommentAnchor `json:"commentAnchor,omitempty"`
+}
+
+// PullRequestReviewedActivity is a participant approving, unapproving or marking the pull request
+// as needing work (ActivityActionReviewed).
+type PullRequestReviewedActivity struct {
+	PullRequestActivityInfo
+
+	Participant PullRequestParticipant `json:"participant"`
+}
+
+// PullRequestRescopedActivity is the source or target branch of the pull request being updated,
+// changing the commits included.
+type PullRequestRescopedActivity struct {
+	PullRequestActivityInfo
+
+	FromHash         string                     `json:"fromHash"`
+	PreviousFromHash string                     `json:"previousFromHash"`
+	ToHash           string                     `json:"toHash"`
+	PreviousToHash   string                     `json:"previousToHash"`
+	Added            PullRequestRescopedCommits `json:"added"`
+	Removed          PullRequestRescopedCommits `json:"removed"`
+}
+
+// PullRequestRescopedCommits lists the commits added or removed by a rescope, Commits may be limited
+// to fewer than Total.
+type PullRequestRescopedCommits struct {
+	Commits []Commit `json:"commits"`
+	Total   int      `json:"total"`
+}
+
+type PullRequestReviewersUpdatedActivity struct {
+	PullRequestActivityInfo
+
+	AddedReviewers   []User `json:"addedReviewers"`
+	RemovedReviewers []User `json:"removedReviewers"`
+}
+
+// PullRequestUpdatedActivity is the title, description or target of the pull request being changed.
+type PullRequestUpdatedActivity struct {
+	PullRequestActivityInfo
+
+	PreviousTitle       string          `json:"previousTitle"`
+	PreviousDescription string          `json:"previousDescription"`
+	PreviousTarget      *PullRequestRef `json:"previousToRef,omitempty"`
+}
+
+type PullRequestMergedActivity struct {
+	PullRequestActivityInfo
+
+	Commit *Commit `json:"commit,omitempty"`
+}
+
+type PullRequestActivityList struct {
+	ListResponse
+
+	Activities []json.RawMessage `json:"values"`
+}
+
+// PullRequestActivityListOptions allows listing activities starting from a comment or activity, e.g.
+// to find the activity of a comment.
+type PullRequestActivityListOptions struct {
+	ListOptions
+
+	FromID   uint64 `url:"fromId,omitempty"`
+	FromType string `url:"fromType,omitempty"`
+}
+
+func (s *ProjectsService) ListPullRequestActivities(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, opts *PullRequestActivityListOptions) ([]PullRequestActivity, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/activities", projectKey, repositorySlug, pullRequestId)
+	var l PullRequestActivityList
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &l, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	activities := make([]PullRequestActivity, len(l.Activities))
+	for i, raw := range l.Activities {
+		activities[i], err = unmarshalPullRequestActivity(raw)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+	return activities, resp, nil
+}
+
+func (s *ProjectsService) ListPullRequestActivitiesIter(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, opts *PullRequestActivityListOptions) iter.Seq2[PullRequestActivity, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]PullRequestActivity, *Response, error) {
+		return s.ListPullRequestActivities(ctx, projectKey, repositorySlug, pullRequestId, o)
+	})
+}
+
+func unmarshalPullRequestActivity(data []byte) (PullRequestActivity, error) {
+	var info PullRequestActivityInfo
+	err := json.Unmarshal(data, &info)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse activity: %w", err)
+	}
+
+	var activity PullRequestActivity
+	switch info.Action {
+	case ActivityActionCommented:
+		activity = &PullRequestCommentActivity{}
+	case ActivityActionApproved, ActivityActionUnapproved, ActivityActionReviewed:
+		activity = &PullRequestReviewedActivity{}
+	case ActivityActionRescoped:
+		activity = &PullRequestRescopedActivity{}
+	case ActivityActionReviewersUpdated:
+		activity = &PullRequestReviewersUpdatedActivity{}
+	case ActivityActionUpdated:
+		activity = &PullRequestUpdatedActivity{}
+	case ActivityActionMerged:
+		activity = &PullRequestMergedActivity{}
+	default:
+		return &info, nil
+	}
+
+	err = json.Unmarshal(data, activity)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s activity: %w", info.Action, err)
+	}
+	return activity, nil
+}
@@ -0,0 +1,164 @@
+package bitbucket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPullRequestActivities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/activities", req.URL.Path)
+		rw.Write([]byte(listPullRequestActivitiesResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	activities, resp, err := client.Projects.ListPullRequestActivities(ctx, "PRJ", "repo", 376, nil)
+	assert.NoError(t, err)
+	assert.True(t, resp.LastPage)
+	assert.Len(t, activities, 7)
+
+	merged, ok := activities[0].(*PullRequestMergedActivity)
+	assert.True(t, ok)
+	assert.Equal(t, ActivityActionMerged, merged.Action)
+	assert.Equal(t, "a1b2c3d4e5f60718293a4b5c6d7e8f9012345678", merged.Commit.ID)
+
+	approved, ok := activities[1].(*PullRequestReviewedActivity)
+	assert.True(t, ok)
+	assert.Equal(t, "jdoe", approved.User.Slug)
+	assert.Equal(t, PullRequestAuthorStatusApproved, approved.Participant.Status)
+
+	comment, ok := activities[2].(*PullRequestCommentActivity)
+	assert.True(t, ok)
+	assert.Equal(t, CommentActionAdded, comment.CommentAction)
+	assert.Equal(t, "Looks good", comment.Comment.Text)
+
+	rescoped, ok := activities[3].(*PullRequestRescopedActivity)
+	assert.True(t, ok)
+	assert.Equal(t, 1, rescoped.Added.Total)
+	assert.Len(t, rescoped.Added.Commits, 1)
+	assert.Equal(t, 0, rescoped.Removed.Total)
+
+	reviewers, ok := activities[4].(*PullRequestReviewersUpdatedActivity)
+	assert.True(t, ok)
+	assert.Len(t, reviewers.AddedReviewers, 1)
+	assert.Empty(t, reviewers.RemovedReviewers)
+
+	updated, ok := activities[5].(*PullRequestUpdatedActivity)
+	assert.True(t, ok)
+	assert.Equal(t, "Bump deps", updated.PreviousTitle)
+
+	opened, ok := activities[6].(*PullRequestActivityInfo)
+	assert.True(t, ok)
+	assert.Equal(t, ActivityActionOpened, opened.Action)
+	assert.Equal(t, uint64(1), opened.Info().ID)
+}
+
+const listPullRequestActivitiesResponse = `{
+  "size": 7,
+  "limit": 25,
+  "isLastPage": true,
+  "values": [
+    {
+      "id": 7,
+      "createdDate": 1718272800000,
+      "user": {"name": "jdoe", "id": 1002, "displayName": "John Doe", "active": true, "slug": "jdoe", "type": "NORMAL"},
+      "action": "MERGED",
+      "commit": {
+        "id": "a1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+        "displayId": "a1b2c3d4e5f",
+        "message": "Merge pull request #376",
+        "authorTimestamp": 1718272800000,
+        "committerTimestamp": 1718272800000,
+        "parents": []
+      }
+    },
+    {
+      "id": 6,
+      "createdDate": 1718269200000,
+      "user": {"name": "jdoe", "id": 1002, "displayName": "John Doe", "active": true, "slug": "jdoe", "type": "NORMAL"},
+      "action": "APPROVED",
+      "participant": {
+        "user": {"name": "jdoe", "id": 1002, "displayName": "John Doe", "active": true, "slug": "jdoe", "type": "NORMAL"},
+        "role": "REVIEWER",
+        "approved": true,
+        "status": "APPROVED",
+        "lastReviewedCommit": "9f8e7d6c5b4a39281706f5e4d3c2b1a098765432"
+      }
+    },
+    {
+      "id": 5,
+      "createdDate": 1718265600000,
+      "user": {"name": "jdoe", "id": 1002, "displayName": "John Doe", "active": true, "slug": "jdoe", "type": "NORMAL"},
+      "action": "COMMENTED",
+      "commentAction": "ADDED",
+      "comment": {
+        "id": 12,
+        "version": 0,
+        "text": "Looks good",
+        "author": {"name": "jdoe", "id": 1002, "displayName": "John Doe", "active": true, "slug": "jdoe", "type": "NORMAL"},
+        "createdDate": 1718265600000,
+        "updatedDate": 1718265600000,
+        "comments": [],
+        "severity": "NORMAL",
+        "state": "OPEN"
+      }
+    },
+    {
+      "id": 4,
+      "createdDate": 1718262000000,
+      "user": {"name": "bot", "id": 1201, "displayName": "Bot", "active": true, "slug": "bot", "type": "SERVICE"},
+      "action": "RESCOPED",
+      "fromHash": "9f8e7d6c5b4a39281706f5e4d3c2b1a098765432",
+      "previousFromHash": "0123456789abcdef0123456789abcdef01234567",
+      "previousToHash": "5fd97804dda64ee31b4541340f9ef16043232518",
+      "toHash": "5fd97804dda64ee31b4541340f9ef16043232518",
+      "added": {
+        "commits": [
+          {
+            "id": "9f8e7d6c5b4a39281706f5e4d3c2b1a098765432",
+            "displayId": "9f8e7d6c5b4",
+            "message": "Bump more deps",
+            "authorTimestamp": 1718262000000,
+            "committerTimestamp": 1718262000000,
+            "parents": [{"id": "0123456789abcdef0123456789abcdef01234567", "displayId": "0123456789a"}]
+          }
+        ],
+        "total": 1
+      },
+      "removed": {
+        "commits": [],
+        "total": 0
+      }
+    },
+    {
+      "id": 3,
+      "createdDate": 1718258400000,
+      "user": {"name": "bot", "id": 1201, "displayName": "Bot", "active": true, "slug": "bot", "type": "SERVICE"},
+      "action": "REVIEWERS_UPDATED",
+      "addedReviewers": [{"name": "jdoe", "id": 1002, "displayName": "John Doe", "active": true, "slug": "jdoe", "type": "NORMAL"}],
+      "removedReviewers": []
+    },
+    {
+      "id": 2,
+      "createdDate": 1718254800000,
+      "user": {"name": "bot", "id": 1201, "displayName": "Bot", "active": true, "slug": "bot", "type": "SERVICE"},
+      "action": "UPDATED",
+      "previousTitle": "Bump deps",
+      "previousDescription": ""
+    },
+    {
+      "id": 1,
+      "createdDate": 1718251200000,
+      "user": {"name": "bot", "id": 1201, "displayName": "Bot", "active": true, "slug": "bot", "type": "SERVICE"},
+      "action": "OPENED"
+    }
+  ],
+  "start": 0
+}`
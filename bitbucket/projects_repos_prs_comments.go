@@ -0,0 +1,287 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// Comment is a comment on a pull request. Replies are nested in Comments.
+type Comment struct {
+	ID             uint64          `json:"id"`
+	Version        uint64          `json:"version"`
+	Text           string          `json:"text"`
+	Author         User            `json:"author"`
+	Created        *DateTime       `json:"createdDate"`
+	Updated        *DateTime       `json:"updatedDate"`
+	Comments       []*Comment      `json:"comments,omitempty"`
+	Anchor         *CommentAnchor  `json:"anchor,omitempty"`
+	Severity       CommentSeverity `json:"severity"`
+	State          CommentState    `json:"state"`
+	ThreadResolved bool            `json:"threadResolved"`
+	Resolver       *User           `json:"resolver,omitempty"`
+	Resolved       *DateTime       `json:"resolvedDate,omitempty"`
+}
+
+// CommentAnchor anchors a comment to a file, or to a line of a file if Line is set.
+type CommentAnchor struct {
+	Path     string          `json:"path"`
+	SrcPath  string          `json:"srcPath,omitempty"`
+	Line     uint64          `json:"line,omitempty"`
+	LineType CommentLineType `json:"lineType,omitempty"`
+	FileType CommentFileType `json:"fileType,omitempty"`
+	DiffType CommentDiffType `json:"diffType,omitempty"`
+	FromHash string          `json:"fromHash,omitempty"`
+	ToHash   string          `json:"toHash,omitempty"`
+	Orphaned bool            `json:"orphaned,omitempty"`
+}
+
+type CommentSeverity string
+
+const (
+	CommentSeverityNormal CommentSeverity = "NORMAL"
+
+	// CommentSeverityBlocker marks the comment as a task which must be resolved before merging, if
+	// required by the merge checks of the repository.
+	CommentSeverityBlocker CommentSeverity = "BLOCKER"
+)
+
+type CommentState string
+
+const (
+	CommentStateOpen     CommentState = "OPEN"
+	CommentStatePending  CommentState = "PENDING"
+	CommentStateResolved CommentState = "RESOLVED"
+)
+
+// CommentLineType is the type of the commented line in the diff.
+type CommentLineType string
+
+const (
+	CommentLineTypeAdded   CommentLineType = "ADDED"
+	CommentLineTypeRemoved CommentLineType = "REMOVED"
+	CommentLineTypeContext CommentLineType = "CONTEXT"
+)
+
+// CommentFileType is the side of the diff the commented line is on.
+type CommentFileType string
+
+const (
+	CommentFileTypeFrom CommentFileType = "FROM"
+	CommentFileTypeTo   CommentFileType = "TO"
+)
+
+// CommentDiffType is the type of diff the comment is anchored to.
+type CommentDiffType string
+
+const (
+	CommentDiffTypeEffective CommentDiffType = "EFFECTIVE"
+	CommentDiffTypeRange     CommentDiffType = "RANGE"
+	CommentDiffTypeCommit    CommentDiffType = "COMMIT"
+)
+
+// CommentAnchorState filters comments by whether the anchor is still present in the diff.
+type CommentAnchorState string
+
+const (
+	CommentAnchorStateActive   CommentAnchorState = "ACTIVE"
+	CommentAnchorStateOrphaned CommentAnchorState = "ORPHANED"
+	CommentAnchorStateAll      CommentAnchorState = "ALL"
+)
+
+// NewFileCommentAnchor returns an anchor for a comment on the file.
+func NewFileCommentAnchor(path string) *CommentAnchor {
+	return &CommentAnchor{Path: path, DiffType: CommentDiffTypeEffective}
+}
+
+// NewLineCommentAnchor returns an anchor for a comment on the line of the file. Lines added or
+// changed are on the TO side of the diff, removed lines on the FROM side.
+func NewLineCommentAnchor(path string, line uint64, lineType CommentLineType) *CommentAnchor {
+	fileType := CommentFileTypeTo
+	if lineType == CommentLineTypeRemoved {
+		fileType = CommentFileTypeFrom
+	}
+	return &CommentAnchor{
+		Path:     path,
+		Line:     line,
+		LineType: lineType,
+		FileType: fileType,
+		DiffType: CommentDiffTypeEffective,
+	}
+}
+
+// CommentCreate defines the comment to add. A general comment is added to the pull request unless
+// Anchor is set, or a reply if ParentID is set.
+type CommentCreate struct {
+	Text     string
+	ParentID uint64
+	Anchor   *CommentAnchor
+	Severity CommentSeverity
+}
+
+// CommentUpdate defines the changes to a comment. Version must be the current version of the comment,
+// other fields are only changed if set.
+type CommentUpdate struct {
+	Version  uint64
+	Text     *string
+	Severity CommentSeverity
+	State    CommentState
+
+	// ThreadResolved resolves or reopens the thread of a root comment.
+	ThreadResolved *bool
+}
+
+type CommentList struct {
+	ListResponse
+
+	Comments []*Comment `json:"values"`
+}
+
+// CommentListOptions filters the comments anchored to the file at Path, which is required.
+type CommentListOptions struct {
+	ListOptions
+
+	Path        string             `url:"path"`
+	AnchorState CommentAnchorState `url:"anchorState,omitempty"`
+	DiffType    CommentDiffType    `url:"diffType,omitempty"`
+	FromHash    string             `url:"fromHash,omitempty"`
+	ToHash      string             `url:"toHash,omitempty"`
+	States      []CommentState     `url:"state,omitempty"`
+}
+
+type BlockerCommentListOptions struct {
+	ListOptions
+
+	States []CommentState `url:"state,omitempty"`
+}
+
+type commentRequest struct {
+	Version        *uint64         `json:"version,omitempty"`
+	Text           *string         `json:"text,omitempty"`
+	Parent         *commentRef     `json:"parent,omitempty"`
+	Anchor         *CommentAnchor  `json:"anchor,omitempty"`
+	Severity       CommentSeverity `json:"severity,omitempty"`
+	State          CommentState    `json:"state,omitempty"`
+	ThreadResolved *bool           `json:"threadResolved,omitempty"`
+}
+
+type commentRef struct {
+	ID uint64 `json:"id"`
+}
+
+func (s *ProjectsService) ListPullRequestComments(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, opts *CommentListOptions) ([]*Comment, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/comments", projectKey, repositorySlug, pullRequestId)
+	var l CommentList
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &l, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Comments, resp, nil
+}
+
+func (s *ProjectsService) ListPullRequestCommentsIter(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, opts *CommentListOptions) iter.Seq2[*Comment, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*Comment, *Response, error) {
+		return s.ListPullRequestComments(ctx, projectKey, repositorySlug, pullRequestId, o)
+	})
+}
+
+// ListPullRequestBlockerComments lists the blocker comments (tasks) of the pull request.
+func (s *ProjectsService) ListPullRequestBlockerComments(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, opts *BlockerCommentListOptions) ([]*Comment, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/blocker-comments", projectKey, repositorySlug, pullRequestId)
+	var l CommentList
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &l, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Comments, resp, nil
+}
+
+func (s *ProjectsService) ListPullRequestBlockerCommentsIter(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, opts *BlockerCommentListOptions) iter.Seq2[*Comment, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*Comment, *Response, error) {
+		return s.ListPullRequestBlockerComments(ctx, projectKey, repositorySlug, pullRequestId, o)
+	})
+}
+
+func (s *ProjectsService) GetPullRequestComment(ctx context.Context, projectKey, repositorySlug string, pullRequestId, commentId uint64) (*Comment, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/comments/%d", projectKey, repositorySlug, pullRequestId, commentId)
+	var c Comment
+	resp, err := s.client.Get(ctx, projectsApiName, p, &c)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &c, resp, nil
+}
+
+func (s *ProjectsService) CreatePullRequestComment(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, comment *CommentCreate) (*Comment, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/comments", projectKey, repositorySlug, pullRequestId)
+	body := &commentRequest{
+		Text:     &comment.Text,
+		Anchor:   comment.Anchor,
+		Severity: comment.Severity,
+	}
+	if comment.ParentID != 0 {
+		body.Parent = &commentRef{ID: comment.ParentID}
+	}
+	return s.sendComment(ctx, "POST", p, body)
+}
+
+// ReplyPullRequestComment adds a reply with the text to the comment.
+func (s *ProjectsService) ReplyPullRequestComment(ctx context.Context, projectKey, repositorySlug string, pullRequestId, parentId uint64, text string) (*Comment, *Response, error) {
+	return s.CreatePullRequestComment(ctx, projectKey, repositorySlug, pullRequestId, &CommentCreate{Text: text, ParentID: parentId})
+}
+
+func (s *ProjectsService) UpdatePullRequestComment(ctx context.Context, projectKey, repositorySlug string, pullRequestId, commentId uint64, update *CommentUpdate) (*Comment, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/comments/%d", projectKey, repositorySlug, pullRequestId, commentId)
+	body := &commentRequest{
+		Version:        &update.Version,
+		Text:           update.Text,
+		Severity:       update.Severity,
+		State:          update.State,
+		ThreadResolved: update.ThreadResolved,
+	}
+	return s.sendComment(ctx, "PUT", p, body)
+}
+
+// ResolvePullRequestComment resolves the blocker comment (task).
+func (s *ProjectsService) ResolvePullRequestComment(ctx context.Context, projectKey, repositorySlug string, pullRequestId, commentId, version uint64) (*Comment, *Response, error) {
+	return s.UpdatePullRequestComment(ctx, projectKey, repositorySlug, pullRequestId, commentId, &CommentUpdate{Version: version, State: CommentStateResolved})
+}
+
+// ReopenPullRequestComment reopens the resolved blocker comment (task).
+func (s *ProjectsService) ReopenPullRequestComment(ctx context.Context, projectKey, repositorySlug string, pullRequestId, commentId, version uint64) (*Comment, *Response, error) {
+	return s.UpdatePullRequestComment(ctx, projectKey, repositorySlug, pullRequestId, commentId, &CommentUpdate{Version: version, State: CommentStateOpen})
+}
+
+// DeletePullRequestComment deletes the comment at the given version, comments with replies cannot be deleted.
+func (s *ProjectsService) DeletePullRequestComment(ctx context.Context, projectKey, repositorySlug string, pullRequestId, commentId, version uint64) (*Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/comments/%d", projectKey, repositorySlug, pullRequestId, commentId)
+	req, err := s.client.NewRequest("DELETE", projectsApiName, p, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = addOptions(req, &versionOptions{Version: version})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *ProjectsService) sendComment(ctx context.Context, method, p string, body *commentRequest) (*Comment, *Response, error) {
+	req, err := s.client.NewRequest(method, projectsApiName, p, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if method == "PUT" {
+		// Updates are versioned, sending an update again after it succeeded fails with a conflict.
+		req = nonIdempotent(req)
+	}
+
+	var c Comment
+	resp, err := s.client.Do(ctx, req, &c)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &c, resp, nil
+}
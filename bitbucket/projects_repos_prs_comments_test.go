@@ -0,0 +1,238 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPullRequestComments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/comments", req.URL.Path)
+		assert.Equal(t, "main.go", req.URL.Query().Get("path"))
+		assert.Equal(t, []string{"OPEN", "PENDING"}, req.URL.Query()["state"])
+		rw.Write([]byte(listPullRequestCommentsResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	comments, resp, err := client.Projects.ListPullRequestComments(ctx, "PRJ", "repo", 376, &CommentListOptions{
+		Path:   "main.go",
+		States: []CommentState{CommentStateOpen, CommentStatePending},
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.LastPage)
+	assert.Len(t, comments, 1)
+	c := comments[0]
+	assert.Equal(t, uint64(42), c.ID)
+	assert.Equal(t, CommentSeverityNormal, c.Severity)
+	assert.Equal(t, "main.go", c.Anchor.Path)
+	assert.Equal(t, uint64(12), c.Anchor.Line)
+	assert.Equal(t, CommentLineTypeAdded, c.Anchor.LineType)
+	assert.Equal(t, CommentFileTypeTo, c.Anchor.FileType)
+	assert.Len(t, c.Comments, 1)
+	assert.Equal(t, "Fixed", c.Comments[0].Text)
+}
+
+func TestListPullRequestBlockerComments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/blocker-comments", req.URL.Path)
+		assert.Equal(t, "OPEN", req.URL.Query().Get("state"))
+		rw.Write([]byte(listPullRequestCommentsResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	comments, err := All(client.Projects.ListPullRequestBlockerCommentsIter(ctx, "PRJ", "repo", 376, &BlockerCommentListOptions{
+		States: []CommentState{CommentStateOpen},
+	}), 0)
+	assert.NoError(t, err)
+	assert.Len(t, comments, 1)
+}
+
+func TestCreatePullRequestComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/comments", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"text":"Possible nil dereference","anchor":{"path":"main.go","line":12,"lineType":"ADDED","fileType":"TO","diffType":"EFFECTIVE"},"severity":"BLOCKER"}`+"\n", string(b))
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(getPullRequestCommentResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	c, _, err := client.Projects.CreatePullRequestComment(ctx, "PRJ", "repo", 376, &CommentCreate{
+		Text:     "Possible nil dereference",
+		Anchor:   NewLineCommentAnchor("main.go", 12, CommentLineTypeAdded),
+		Severity: CommentSeverityBlocker,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(42), c.ID)
+	assert.Equal(t, CommentStateOpen, c.State)
+}
+
+func TestReplyPullRequestComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/comments", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"text":"Fixed","parent":{"id":42}}`+"\n", string(b))
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(getPullRequestCommentResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.ReplyPullRequestComment(ctx, "PRJ", "repo", 376, 42, "Fixed")
+	assert.NoError(t, err)
+}
+
+func TestUpdatePullRequestComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/comments/42", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"version":1,"text":"Edited"}`+"\n", string(b))
+		rw.Write([]byte(getPullRequestCommentResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.UpdatePullRequestComment(ctx, "PRJ", "repo", 376, 42, &CommentUpdate{Version: 1, Text: Ptr("Edited")})
+	assert.NoError(t, err)
+}
+
+func TestResolvePullRequestComment(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/comments/42", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(b))
+		rw.Write([]byte(getPullRequestCommentResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.ResolvePullRequestComment(ctx, "PRJ", "repo", 376, 42, 1)
+	assert.NoError(t, err)
+	_, _, err = client.Projects.ReopenPullRequestComment(ctx, "PRJ", "repo", 376, 42, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`{"version":1,"state":"RESOLVED"}` + "\n",
+		`{"version":2,"state":"OPEN"}` + "\n",
+	}, bodies)
+}
+
+func TestDeletePullRequestComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/comments/42", req.URL.Path)
+		assert.Equal(t, "3", req.URL.Query().Get("version"))
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.Projects.DeletePullRequestComment(ctx, "PRJ", "repo", 376, 42, 3)
+	assert.NoError(t, err)
+}
+
+const getPullRequestCommentResponse = `{
+  "id": 42,
+  "version": 1,
+  "text": "Possible nil dereference",
+  "author": {
+    "name": "bot",
+    "emailAddress": "bot@example.com",
+    "active": true,
+    "displayName": "Analysis Bot",
+    "id": 1201,
+    "slug": "bot",
+    "type": "SERVICE"
+  },
+  "createdDate": 1718180400000,
+  "updatedDate": 1718180400000,
+  "comments": [],
+  "anchor": {
+    "line": 12,
+    "lineType": "ADDED",
+    "fileType": "TO",
+    "path": "main.go",
+    "diffType": "EFFECTIVE",
+    "orphaned": false
+  },
+  "threadResolved": false,
+  "severity": "BLOCKER",
+  "state": "OPEN"
+}`
+
+const listPullRequestCommentsResponse = `{
+  "size": 1,
+  "limit": 25,
+  "isLastPage": true,
+  "values": [
+    {
+      "id": 42,
+      "version": 0,
+      "text": "Possible nil dereference",
+      "author": {
+        "name": "bot",
+        "active": true,
+        "displayName": "Analysis Bot",
+        "id": 1201,
+        "slug": "bot",
+        "type": "SERVICE"
+      },
+      "createdDate": 1718180400000,
+      "updatedDate": 1718180400000,
+      "comments": [
+        {
+          "id": 43,
+          "version": 0,
+          "text": "Fixed",
+          "author": {
+            "name": "jdoe",
+            "active": true,
+            "displayName": "John Doe",
+            "id": 1002,
+            "slug": "jdoe",
+            "type": "NORMAL"
+          },
+          "createdDate": 1718184000000,
+          "updatedDate": 1718184000000,
+          "comments": [],
+          "threadResolved": false,
+          "severity": "NORMAL",
+          "state": "OPEN"
+        }
+      ],
+      "anchor": {
+        "line": 12,
+        "lineType": "ADDED",
+        "fileType": "TO",
+        "path": "main.go",
+        "diffType": "EFFECTIVE",
+        "orphaned": false
+      },
+      "threadResolved": false,
+      "severity": "NORMAL",
+      "state": "OPEN"
+    }
+  ],
+  "start": 0
+}`
@@ -0,0 +1,104 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+type PullRequestParticipantList struct {
+	ListResponse
+
+	Participants []*PullRequestParticipant `json:"values"`
+}
+
+// ParticipantListOptions filters the participants by role. The filter is applied to each page by the
+// client, pages may thus contain fewer participants than requested.
+type ParticipantListOptions struct {
+	ListOptions
+
+	Role PullRequestAuthorRole `url:"-"`
+}
+
+type participantRequest struct {
+	User   *userRef                `json:"user,omitempty"`
+	Role   PullRequestAuthorRole   `json:"role,omitempty"`
+	Status PullRequestAuthorStatus `json:"status,omitempty"`
+	Commit string                  `json:"lastReviewedCommit,omitempty"`
+}
+
+func (s *ProjectsService) ListPullRequestParticipants(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, opts *ParticipantListOptions) ([]*PullRequestParticipant, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/participants", projectKey, repositorySlug, pullRequestId)
+	var l PullRequestParticipantList
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &l, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	if opts == nil || opts.Role == "" {
+		return l.Participants, resp, nil
+	}
+
+	participants := make([]*PullRequestParticipant, 0, len(l.Participants))
+	for _, pp := range l.Participants {
+		if pp.Role == opts.Role {
+			participants = append(participants, pp)
+		}
+	}
+	return participants, resp, nil
+}
+
+func (s *ProjectsService) ListPullRequestParticipantsIter(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, opts *ParticipantListOptions) iter.Seq2[*PullRequestParticipant, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*PullRequestParticipant, *Response, error) {
+		return s.ListPullRequestParticipants(ctx, projectKey, repositorySlug, pullRequestId, o)
+	})
+}
+
+// SetPullRequestParticipantStatus sets the review status of the participant, which must be the
+// authenticated user. If lastReviewedCommit is set the status only applies if it is still the latest
+// commit of the pull request, guarding against approving commits not reviewed.
+func (s *ProjectsService) SetPullRequestParticipantStatus(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, userSlug string, status PullRequestAuthorStatus, lastReviewedCommit string) (*PullRequestParticipant, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/participants/%s", projectKey, repositorySlug, pullRequestId, userSlug)
+	return s.sendParticipant(ctx, "PUT", p, &participantRequest{Status: status, Commit: lastReviewedCommit})
+}
+
+func (s *ProjectsService) ApprovePullRequest(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, userSlug, lastReviewedCommit string) (*PullRequestParticipant, *Response, error) {
+	return s.SetPullRequestParticipantStatus(ctx, projectKey, repositorySlug, pullRequestId, userSlug, PullRequestAuthorStatusApproved, lastReviewedCommit)
+}
+
+func (s *ProjectsService) UnapprovePullRequest(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, userSlug string) (*PullRequestParticipant, *Response, error) {
+	return s.SetPullRequestParticipantStatus(ctx, projectKey, repositorySlug, pullRequestId, userSlug, PullRequestAuthorStatusUnapproved, "")
+}
+
+func (s *ProjectsService) MarkPullRequestNeedsWork(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, userSlug, lastReviewedCommit string) (*PullRequestParticipant, *Response, error) {
+	return s.SetPullRequestParticipantStatus(ctx, projectKey, repositorySlug, pullRequestId, userSlug, PullRequestAuthorStatusNeedsWork, lastReviewedCommit)
+}
+
+// AddPullRequestReviewer adds the user with the given name as reviewer of the pull request.
+func (s *ProjectsService) AddPullRequestReviewer(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, userName string) (*PullRequestParticipant, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/participants", projectKey, repositorySlug, pullRequestId)
+	return s.sendParticipant(ctx, "POST", p, &participantRequest{User: &userRef{Name: userName}, Role: PullRequestAuthorRoleReviewer})
+}
+
+func (s *ProjectsService) RemovePullRequestReviewer(ctx context.Context, projectKey, repositorySlug string, pullRequestId uint64, userSlug string) (*Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%d/participants/%s", projectKey, repositorySlug, pullRequestId, userSlug)
+	req, err := s.client.NewRequest("DELETE", projectsApiName, p, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *ProjectsService) sendParticipant(ctx context.Context, method, p string, body *participantRequest) (*PullRequestParticipant, *Response, error) {
+	req, err := s.client.NewRequest(method, projectsApiName, p, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pp PullRequestParticipant
+	resp, err := s.client.Do(ctx, req, &pp)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &pp, resp, nil
+}
@@ -0,0 +1,127 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPullRequestParticipants(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/participants", req.URL.Path)
+		assert.Empty(t, req.URL.Query().Get("role"))
+		rw.Write([]byte(listPullRequestParticipantsResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	participants, _, err := client.Projects.ListPullRequestParticipants(ctx, "PRJ", "repo", 376, nil)
+	assert.NoError(t, err)
+	assert.Len(t, participants, 2)
+
+	reviewers, err := All(client.Projects.ListPullRequestParticipantsIter(ctx, "PRJ", "repo", 376, &ParticipantListOptions{Role: PullRequestAuthorRoleReviewer}), 0)
+	assert.NoError(t, err)
+	assert.Len(t, reviewers, 1)
+	assert.Equal(t, "jdoe", reviewers[0].Author.Slug)
+	assert.Equal(t, PullRequestAuthorStatusNeedsWork, reviewers[0].Status)
+}
+
+func TestApprovePullRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/participants/bot", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"status":"APPROVED","lastReviewedCommit":"5fd97804dda64ee31b4541340f9ef16043232518"}`+"\n", string(b))
+		rw.Write([]byte(setPullRequestParticipantStatusResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	participant, _, err := client.Projects.ApprovePullRequest(ctx, "PRJ", "repo", 376, "bot", "5fd97804dda64ee31b4541340f9ef16043232518")
+	assert.NoError(t, err)
+	assert.True(t, participant.Approved)
+	assert.Equal(t, PullRequestAuthorStatusApproved, participant.Status)
+	assert.Equal(t, "5fd97804dda64ee31b4541340f9ef16043232518", participant.Commit)
+}
+
+func TestUnapprovePullRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"status":"UNAPPROVED"}`+"\n", string(b))
+		rw.Write([]byte(setPullRequestParticipantStatusResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.UnapprovePullRequest(ctx, "PRJ", "repo", 376, "bot")
+	assert.NoError(t, err)
+}
+
+func TestAddPullRequestReviewer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/participants", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"user":{"name":"jdoe"},"role":"REVIEWER"}`+"\n", string(b))
+		rw.Write([]byte(setPullRequestParticipantStatusResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.AddPullRequestReviewer(ctx, "PRJ", "repo", 376, "jdoe")
+	assert.NoError(t, err)
+}
+
+func TestRemovePullRequestReviewer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/participants/jdoe", req.URL.Path)
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.Projects.RemovePullRequestReviewer(ctx, "PRJ", "repo", 376, "jdoe")
+	assert.NoError(t, err)
+}
+
+const setPullRequestParticipantStatusResponse = `{
+  "user": {"name": "bot", "id": 1201, "displayName": "Bot", "active": true, "slug": "bot", "type": "SERVICE"},
+  "lastReviewedCommit": "5fd97804dda64ee31b4541340f9ef16043232518",
+  "role": "REVIEWER",
+  "approved": true,
+  "status": "APPROVED"
+}`
+
+const listPullRequestParticipantsResponse = `{
+  "size": 2,
+  "limit": 25,
+  "isLastPage": true,
+  "values": [
+    {
+      "user": {"name": "bot", "id": 1201, "displayName": "Bot", "active": true, "slug": "bot", "type": "SERVICE"},
+      "role": "AUTHOR",
+      "approved": false,
+      "status": "UNAPPROVED"
+    },
+    {
+      "user": {"name": "jdoe", "id": 1002, "displayName": "John Doe", "active": true, "slug": "jdoe", "type": "NORMAL"},
+      "lastReviewedCommit": "5fd97804dda64ee31b4541340f9ef16043232518",
+      "role": "REVIEWER",
+      "approved": false,
+      "status": "NEEDS_WORK"
+    }
+  ],
+  "start": 0
+}`
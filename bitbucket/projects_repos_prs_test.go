@@ -3,6 +3,7 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -88,6 +89,198 @@ func TestListPullRequestChanges(t *testing.T) {
 	}
 }
 
+func TestCreatePullRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"title":"Bump deps","description":"Automated update","fromRef":{"id":"refs/heads/deps","repository":{"slug":"repo","project":{"key":"PRJ"}}},"toRef":{"id":"refs/heads/main","repository":{"slug":"repo","project":{"key":"PRJ"}}},"reviewers":[{"user":{"name":"jdoe"}}]}`+"\n", string(b))
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(getPullRequestResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	pr, _, err := client.Projects.CreatePullRequest(ctx, "PRJ", "repo", &PullRequestCreate{
+		Title:       "Bump deps",
+		Description: "Automated update",
+		Source:      NewPullRequestRefSpec("PRJ", "repo", "refs/heads/deps"),
+		Target:      NewPullRequestRefSpec("PRJ", "repo", "refs/heads/main"),
+		Reviewers:   []string{"jdoe"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(376), pr.ID)
+	assert.Equal(t, "Merge request to promote  internal-1  to: innovators-1", pr.Description)
+}
+
+func TestUpdatePullRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"version":3,"title":"New title","reviewers":[]}`+"\n", string(b))
+		rw.Write([]byte(getPullRequestResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.UpdatePullRequest(ctx, "PRJ", "repo", 376, &PullRequestUpdate{
+		Version:   3,
+		Title:     Ptr("New title"),
+		Reviewers: []string{},
+	})
+	assert.NoError(t, err)
+}
+
+func TestUpdatePullRequestOutOfDate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusConflict)
+		rw.Write([]byte(`{"errors":[{"context":null,"message":"You are attempting to modify a pull request based on out-of-date information.","exceptionName":"com.atlassian.bitbucket.pull.PullRequestOutOfDateException","currentVersion":4}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.UpdatePullRequest(ctx, "PRJ", "repo", 376, &PullRequestUpdate{Version: 3})
+	assert.True(t, IsConflict(err))
+	assert.True(t, IsException(err, ExceptionPullRequestOutOfDate))
+}
+
+func TestMergePullRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/merge", req.URL.Path)
+		assert.Equal(t, "3", req.URL.Query().Get("version"))
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"version":3,"strategyId":"squash","message":"Bump deps"}`+"\n", string(b))
+		rw.Write([]byte(getPullRequestResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.MergePullRequest(ctx, "PRJ", "repo", 376, 3, &PullRequestMergeOptions{
+		Strategy: PullRequestMergeStrategySquash,
+		Message:  "Bump deps",
+	})
+	assert.NoError(t, err)
+}
+
+func TestDeclineAndReopenPullRequest(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "2", req.URL.Query().Get("version"))
+		paths = append(paths, req.URL.Path)
+		rw.Write([]byte(getPullRequestResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.DeclinePullRequest(ctx, "PRJ", "repo", 376, 2)
+	assert.NoError(t, err)
+	_, _, err = client.Projects.ReopenPullRequest(ctx, "PRJ", "repo", 376, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"/api/latest/projects/PRJ/repos/repo/pull-requests/376/decline",
+		"/api/latest/projects/PRJ/repos/repo/pull-requests/376/reopen",
+	}, paths)
+}
+
+func TestDeletePullRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"version":2}`+"\n", string(b))
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.Projects.DeletePullRequest(ctx, "PRJ", "repo", 376, 2)
+	assert.NoError(t, err)
+}
+
+func TestCanMergePullRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/merge", req.URL.Path)
+		rw.Write([]byte(canMergePullRequestResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	status, _, err := client.Projects.CanMergePullRequest(ctx, "PRJ", "repo", 376)
+	assert.NoError(t, err)
+	assert.False(t, status.CanMerge)
+	assert.False(t, status.Conflicted)
+	assert.Equal(t, PullRequestMergeOutcomeClean, status.Outcome)
+	assert.Len(t, status.Vetoes, 2)
+	assert.Equal(t, "Not all required reviewers have approved yet", status.Vetoes[0].Summary)
+	assert.Equal(t, "Not all required builds are successful yet", status.Vetoes[1].Summary)
+}
+
+func TestListPullRequestCommits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/commits", req.URL.Path)
+		rw.Write([]byte(listPullRequestCommitsResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	commits, _, err := client.Projects.ListPullRequestCommits(ctx, "PRJ", "repo", 376, nil)
+	assert.NoError(t, err)
+	assert.Len(t, commits, 1)
+	assert.Equal(t, "9f8e7d6c5b4a39281706f5e4d3c2b1a098765432", commits[0].ID)
+	assert.Len(t, commits[0].Parents, 1)
+}
+
+func TestGetPullRequestDiff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/pull-requests/376/diff/go.mod", req.URL.Path)
+		assert.Equal(t, "3", req.URL.Query().Get("contextLines"))
+		assert.Equal(t, "ignore-all", req.URL.Query().Get("whitespace"))
+		assert.Equal(t, "0123456789abcdef0123456789abcdef01234567", req.URL.Query().Get("sinceId"))
+		assert.False(t, req.URL.Query().Has("untilId"))
+		assert.False(t, req.URL.Query().Has("Path"))
+		rw.Write([]byte(getPullRequestDiffResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	diff, _, err := client.Projects.GetPullRequestDiff(ctx, "PRJ", "repo", 376, &PullRequestDiffOptions{
+		DiffOptions: DiffOptions{
+			Path:         "go.mod",
+			ContextLines: Ptr(3),
+			Whitespace:   DiffWhitespaceIgnoreAll,
+		},
+		SinceID: "0123456789abcdef0123456789abcdef01234567",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, diff.Diffs, 1)
+	d := diff.Diffs[0]
+	assert.Equal(t, "go.mod", d.Destination.Title)
+	assert.Len(t, d.Hunks, 1)
+	h := d.Hunks[0]
+	assert.Equal(t, 5, h.DestinationLine)
+	assert.Len(t, h.Segments, 3)
+	assert.Equal(t, DiffSegmentTypeRemoved, h.Segments[1].Type)
+	assert.Equal(t, 6, h.Segments[1].Lines[0].Source)
+	assert.Equal(t, DiffSegmentTypeAdded, h.Segments[2].Type)
+	assert.Equal(t, 6, h.Segments[2].Lines[0].Destination)
+	assert.Equal(t, "\tgithub.com/stretchr/testify v1.9.0", h.Segments[2].Lines[0].Line)
+}
+
 const searchPullRequestsResponse = `{
 	"size": 1,
 	"limit": 25,
@@ -533,3 +726,79 @@ const listPullRequestChangesResponse = `
   "nextPageStart": null
 }
 `
+
+const canMergePullRequestResponse = `{
+  "canMerge": false,
+  "conflicted": false,
+  "outcome": "CLEAN",
+  "vetoes": [
+    {
+      "summaryMessage": "Not all required reviewers have approved yet",
+      "detailedMessage": "At least 2 approvals are required before this pull request can be merged."
+    },
+    {
+      "summaryMessage": "Not all required builds are successful yet",
+      "detailedMessage": "You cannot merge this pull request while it has failed builds."
+    }
+  ]
+}`
+
+const listPullRequestCommitsResponse = `{
+  "size": 1,
+  "limit": 25,
+  "isLastPage": true,
+  "values": [
+    {
+      "id": "9f8e7d6c5b4a39281706f5e4d3c2b1a098765432",
+      "displayId": "9f8e7d6c5b4",
+      "author": {"name": "bot", "emailAddress": "bot@example.com"},
+      "authorTimestamp": 1718262000000,
+      "committer": {"name": "bot", "emailAddress": "bot@example.com"},
+      "committerTimestamp": 1718262000000,
+      "message": "Bump testify",
+      "parents": [{"id": "0123456789abcdef0123456789abcdef01234567", "displayId": "0123456789a"}]
+    }
+  ],
+  "start": 0
+}`
+
+const getPullRequestDiffResponse = `{
+  "fromHash": "0123456789abcdef0123456789abcdef01234567",
+  "toHash": "9f8e7d6c5b4a39281706f5e4d3c2b1a098765432",
+  "contextLines": 3,
+  "whitespace": "IGNORE_ALL",
+  "diffs": [
+    {
+      "source": {"components": ["go.mod"], "parent": "", "name": "go.mod", "toString": "go.mod"},
+      "destination": {"components": ["go.mod"], "parent": "", "name": "go.mod", "toString": "go.mod"},
+      "hunks": [
+        {
+          "sourceLine": 5,
+          "sourceSpan": 2,
+          "destinationLine": 5,
+          "destinationSpan": 2,
+          "segments": [
+            {
+              "type": "CONTEXT",
+              "lines": [{"destination": 5, "source": 5, "line": "require (", "truncated": false}],
+              "truncated": false
+            },
+            {
+              "type": "REMOVED",
+              "lines": [{"destination": 6, "source": 6, "line": "\tgithub.com/stretchr/testify v1.8.4", "truncated": false}],
+              "truncated": false
+            },
+            {
+              "type": "ADDED",
+              "lines": [{"destination": 6, "source": 7, "line": "\tgithub.com/stretchr/testify v1.9.0", "truncated": false}],
+              "truncated": false
+            }
+          ],
+          "truncated": false
+        }
+      ],
+      "truncated": false
+    }
+  ],
+  "truncated": false
+}`
@@ -0,0 +1,103 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+const gitApiName = "git"
+
+type TagList struct {
+	ListResponse
+
+	Tags []*Tag `json:"values"`
+}
+
+type Tag struct {
+	ID              string  `json:"id"`
+	DisplayID       string  `json:"displayId"`
+	Type            TagType `json:"type"`
+	LatestCommit    string  `json:"latestCommit"`
+	LatestChangeset string  `json:"latestChangeset"`
+
+	// Hash is the ID of the tag object for annotated tags, empty for lightweight tags.
+	Hash string `json:"hash,omitempty"`
+}
+
+type TagType string
+
+type TagCreate struct {
+	Name string `json:"name"`
+
+	// StartPoint is the commit ID or ref to tag.
+	StartPoint string `json:"startPoint"`
+
+	// Message creates an annotated tag when set, otherwise a lightweight tag is created.
+	Message string `json:"message,omitempty"`
+}
+
+type TagSearchOptions struct {
+	ListOptions
+
+	Filter string         `url:"filterText,omitempty"`
+	Order  TagSearchOrder `url:"orderBy,omitempty"`
+}
+
+type TagSearchOrder string
+
+const (
+	TagSearchOrderAlpha    TagSearchOrder = "ALPHABETICAL"
+	TagSearchOrderModified TagSearchOrder = "MODIFICATION"
+)
+
+func (s *ProjectsService) SearchTags(ctx context.Context, projectKey, repositorySlug string, opts *TagSearchOptions) ([]*Tag, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/tags", projectKey, repositorySlug)
+	var l TagList
+	resp, err := s.client.GetPaged(ctx, projectsApiName, p, &l, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Tags, resp, nil
+}
+
+func (s *ProjectsService) SearchTagsIter(ctx context.Context, projectKey, repositorySlug string, opts *TagSearchOptions) iter.Seq2[*Tag, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, &o.ListOptions, func(ctx context.Context) ([]*Tag, *Response, error) {
+		return s.SearchTags(ctx, projectKey, repositorySlug, o)
+	})
+}
+
+func (s *ProjectsService) GetTag(ctx context.Context, projectKey, repositorySlug, name string) (*Tag, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/tags/%s", projectKey, repositorySlug, name)
+	var t Tag
+	resp, err := s.client.Get(ctx, projectsApiName, p, &t)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &t, resp, nil
+}
+
+func (s *ProjectsService) CreateTag(ctx context.Context, projectKey, repositorySlug string, tag *TagCreate) (*Tag, *Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/tags", projectKey, repositorySlug)
+	req, err := s.client.NewRequest("POST", projectsApiName, p, tag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var t Tag
+	resp, err := s.client.Do(ctx, req, &t)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &t, resp, nil
+}
+
+func (s *ProjectsService) DeleteTag(ctx context.Context, projectKey, repositorySlug, name string) (*Response, error) {
+	p := fmt.Sprintf("projects/%s/repos/%s/tags/%s", projectKey, repositorySlug, name)
+	req, err := s.client.NewRequest("DELETE", gitApiName, p, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
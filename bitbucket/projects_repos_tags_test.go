@@ -0,0 +1,113 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/tags", req.URL.Path)
+		assert.Equal(t, "v1", req.URL.Query().Get("filterText"))
+		assert.Equal(t, "MODIFICATION", req.URL.Query().Get("orderBy"))
+		rw.Write([]byte(searchTagsResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	tags, resp, err := client.Projects.SearchTags(ctx, "PRJ", "repo", &TagSearchOptions{Filter: "v1", Order: TagSearchOrderModified})
+	assert.NoError(t, err)
+	assert.True(t, resp.LastPage)
+	assert.Len(t, tags, 2)
+	assert.Equal(t, "refs/tags/v1.1.0", tags[0].ID)
+	assert.Equal(t, "v1.1.0", tags[0].DisplayID)
+	assert.Equal(t, "0a943a29376f2336b78312d99e65da17048951db", tags[0].Hash)
+	assert.Empty(t, tags[1].Hash)
+}
+
+func TestGetTag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/tags/v1.0.0", req.URL.Path)
+		rw.Write([]byte(getTagResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	tag, _, err := client.Projects.GetTag(ctx, "PRJ", "repo", "v1.0.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "refs/tags/v1.0.0", tag.ID)
+	assert.Equal(t, "8d51122def5632836d1cb1026e879069e10a1e13", tag.LatestCommit)
+}
+
+func TestCreateTag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/tags", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"name\":\"v1.0.0\",\"startPoint\":\"refs/heads/main\",\"message\":\"Release 1.0.0\"}\n", string(b))
+		rw.Write([]byte(getTagResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	tag, _, err := client.Projects.CreateTag(ctx, "PRJ", "repo", &TagCreate{Name: "v1.0.0", StartPoint: "refs/heads/main", Message: "Release 1.0.0"})
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.0.0", tag.DisplayID)
+}
+
+func TestDeleteTag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/git/latest/projects/PRJ/repos/repo/tags/v1.0.0", req.URL.Path)
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.Projects.DeleteTag(ctx, "PRJ", "repo", "v1.0.0")
+	assert.NoError(t, err)
+}
+
+const searchTagsResponse = `{
+  "size": 2,
+  "limit": 25,
+  "isLastPage": true,
+  "values": [
+    {
+      "id": "refs/tags/v1.1.0",
+      "displayId": "v1.1.0",
+      "type": "TAG",
+      "latestCommit": "ae25676b718886fcc0d14fedc5ef72d1b0762c63",
+      "latestChangeset": "ae25676b718886fcc0d14fedc5ef72d1b0762c63",
+      "hash": "0a943a29376f2336b78312d99e65da17048951db"
+    },
+    {
+      "id": "refs/tags/v1.0.0",
+      "displayId": "v1.0.0",
+      "type": "TAG",
+      "latestCommit": "8d51122def5632836d1cb1026e879069e10a1e13",
+      "latestChangeset": "8d51122def5632836d1cb1026e879069e10a1e13"
+    }
+  ],
+  "start": 0
+}`
+
+const getTagResponse = `{
+  "id": "refs/tags/v1.0.0",
+  "displayId": "v1.0.0",
+  "type": "TAG",
+  "latestCommit": "8d51122def5632836d1cb1026e879069e10a1e13",
+  "latestChangeset": "8d51122def5632836d1cb1026e879069e10a1e13",
+  "hash": "0a943a29376f2336b78312d99e65da17048951db"
+}`
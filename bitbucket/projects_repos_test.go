@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -103,6 +104,46 @@ func TestCreateRepository(t *testing.T) {
 	assert.ElementsMatch(t, []Link{{Href: "https://git/scm/pd/go-bitbucket-demo.git", Name: "http"}, {Href: "ssh://git@git:7999/pd/go-bitbucket-demo.git", Name: "ssh"}}, repo.Links["clone"])
 }
 
+func TestUpdateRepository(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"name\":\"renamed\",\"description\":\"\",\"public\":false,\"archived\":true,\"defaultBranch\":\"refs/heads/main\",\"project\":{\"key\":\"ARCHIVE\"}}\n", string(b))
+		rw.Write([]byte(getProjectsRepositoryResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	in := &RepositoryUpdate{
+		Name:          Ptr("renamed"),
+		Description:   Ptr(""),
+		Public:        Ptr(false),
+		Archived:      Ptr(true),
+		DefaultBranch: "refs/heads/main",
+		Project:       &ProjectRef{Key: "ARCHIVE"},
+	}
+	repo, _, err := client.Projects.UpdateRepository(ctx, "PRJ", "repo", in)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1405), repo.ID)
+}
+
+func TestUpdateRepositoryUnarchive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"archived\":false}\n", string(b))
+		rw.Write([]byte(getProjectsRepositoryResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.UpdateRepository(ctx, "PRJ", "repo", &RepositoryUpdate{Archived: Ptr(false)})
+	assert.NoError(t, err)
+}
+
 func TestDeleteRepository(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		assert.Equal(t, "DELETE", req.Method)
@@ -117,6 +158,59 @@ func TestDeleteRepository(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestForkRepository(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"name\":\"my-fork\",\"project\":{\"key\":\"~jdoe\"}}\n", string(b))
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(forkRepositoryResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	fork, _, err := client.Projects.ForkRepository(ctx, "PRJ", "repo", &RepositoryFork{Name: "my-fork", Project: &ProjectRef{Key: PersonalProjectKey("jdoe")}})
+	assert.NoError(t, err)
+	assert.Equal(t, "my-fork", fork.Slug)
+	assert.NotNil(t, fork.Origin)
+	assert.Equal(t, "repo", fork.Origin.Slug)
+	assert.Equal(t, "PRJ", fork.Origin.Project.Key)
+}
+
+func TestListForks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/forks", req.URL.Path)
+		rw.Write([]byte(`{"size":1,"limit":25,"isLastPage":true,"start":0,"values":[` + forkRepositoryResponse + `]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	forks, resp, err := client.Projects.ListForks(ctx, "PRJ", "repo", nil)
+	assert.NoError(t, err)
+	assert.True(t, resp.LastPage)
+	assert.Len(t, forks, 1)
+	assert.Equal(t, "repo", forks[0].Origin.Slug)
+}
+
+func TestListRelatedRepositories(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/repo/related", req.URL.Path)
+		rw.Write([]byte(listProjectsRepositoriesResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	repos, _, err := client.Projects.ListRelatedRepositories(ctx, "PRJ", "repo", nil)
+	assert.NoError(t, err)
+	assert.Len(t, repos, 3)
+}
+
 func TestListFiles(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		assert.Equal(t, "GET", req.Method)
@@ -184,6 +278,195 @@ func TestGetTextFileContentList(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestGetRawFile(t *testing.T) {
+	png := []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n', 0x00}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/REPO/raw/img/logo.png", req.URL.Path)
+		assert.Equal(t, "refs/heads/main", req.URL.Query().Get("at"))
+		rw.Header().Set("Content-Type", "image/png")
+		rw.Write(png)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	f, _, err := client.Projects.GetRawFile(ctx, "PRJ", "REPO", "img/logo.png", "refs/heads/main")
+	assert.NoError(t, err)
+	defer f.Close()
+	assert.Equal(t, "image/png", f.ContentType)
+	assert.Equal(t, int64(len(png)), f.Size)
+	b, err := io.ReadAll(f)
+	assert.NoError(t, err)
+	assert.Equal(t, png, b)
+}
+
+func TestGetRawFileNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.False(t, req.URL.Query().Has("at"))
+		rw.WriteHeader(http.StatusNotFound)
+		rw.Write([]byte(`{"errors":[{"context":null,"message":"The path \"missing.txt\" does not exist at revision \"refs/heads/main\"","exceptionName":"com.atlassian.bitbucket.content.NoSuchPathException"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	f, _, err := client.Projects.GetRawFile(ctx, "PRJ", "REPO", "missing.txt", "")
+	assert.Nil(t, f)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestEditFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ/repos/REPO/browse/config/app.yaml", req.URL.Path)
+		assert.Equal(t, "no-check", req.Header.Get("X-Atlassian-Token"))
+		err := req.ParseMultipartForm(1024)
+		assert.NoError(t, err)
+		assert.Equal(t, "bump", req.FormValue("branch"))
+		assert.Equal(t, "Bump replicas", req.FormValue("message"))
+		assert.Equal(t, "5fd97804dda64ee31b4541340f9ef16043232518", req.FormValue("sourceCommitId"))
+		assert.Equal(t, "main", req.FormValue("sourceBranch"))
+		f, fh, err := req.FormFile("content")
+		assert.NoError(t, err)
+		assert.Equal(t, "app.yaml", fh.Filename)
+		b, _ := io.ReadAll(f)
+		assert.Equal(t, "replicas: 3\n", string(b))
+		rw.Write([]byte(editFileResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	c, _, err := client.Projects.EditFile(ctx, "PRJ", "REPO", "config/app.yaml", &FileEdit{
+		Content:        []byte("replicas: 3\n"),
+		Branch:         "bump",
+		Message:        "Bump replicas",
+		SourceCommitID: "5fd97804dda64ee31b4541340f9ef16043232518",
+		SourceBranch:   "main",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "c4a0ba4d1e2a6e8b0b0c7e9f4f0e9d0a6b7c8d9e", c.ID)
+	assert.Equal(t, "Bump replicas", c.Message)
+	assert.Len(t, c.Parents, 1)
+}
+
+func TestEditFileNewFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		err := req.ParseMultipartForm(1024)
+		assert.NoError(t, err)
+		assert.Equal(t, "main", req.FormValue("branch"))
+		_, ok := req.MultipartForm.Value["sourceCommitId"]
+		assert.False(t, ok)
+		rw.Write([]byte(editFileResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.EditFile(ctx, "PRJ", "REPO", "NEW.md", &FileEdit{
+		Content: []byte("# New"),
+		Branch:  "main",
+		Message: "Add NEW.md",
+	})
+	assert.NoError(t, err)
+}
+
+func TestEditFileNotRetried(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+		if calls == 1 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		rw.Write([]byte(editFileResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	client.RetryPolicy = &RetryPolicy{MinBackoff: time.Millisecond}
+	ctx := context.Background()
+	_, resp, err := client.Projects.EditFile(ctx, "PRJ", "REPO", "NEW.md", &FileEdit{Content: []byte("# New"), Branch: "main"})
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 1, calls)
+
+	calls = 0
+	client.RetryPolicy.RetryNonIdempotent = true
+	_, _, err = client.Projects.EditFile(ctx, "PRJ", "REPO", "NEW.md", &FileEdit{Content: []byte("# New"), Branch: "main"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestEditFileRedirect(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/latest/projects/PRJ/repos/REPO/browse/NEW.md", func(rw http.ResponseWriter, req *http.Request) {
+		http.Redirect(rw, req, "/api/latest/projects/PRJ/repos/RENAMED/browse/NEW.md", http.StatusTemporaryRedirect)
+	})
+	mux.HandleFunc("/api/latest/projects/PRJ/repos/RENAMED/browse/NEW.md", func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.NoError(t, req.ParseMultipartForm(1<<20))
+		assert.Equal(t, "main", req.FormValue("branch"))
+		rw.Write([]byte(editFileResponse))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	_, _, err := client.Projects.EditFile(context.Background(), "PRJ", "REPO", "NEW.md", &FileEdit{Content: []byte("# New"), Branch: "main"})
+	assert.NoError(t, err)
+}
+
+func TestEditFileNil(t *testing.T) {
+	client, _ := NewClient("http://localhost", nil)
+	_, _, err := client.Projects.EditFile(context.Background(), "PRJ", "REPO", "NEW.md", nil)
+	assert.Error(t, err)
+}
+
+const editFileResponse = `{
+  "id": "c4a0ba4d1e2a6e8b0b0c7e9f4f0e9d0a6b7c8d9e",
+  "displayId": "c4a0ba4d1e2",
+  "author": {"name": "bot", "emailAddress": "bot@example.com"},
+  "authorTimestamp": 1718272800000,
+  "committer": {"name": "bot", "emailAddress": "bot@example.com"},
+  "committerTimestamp": 1718272800000,
+  "message": "Bump replicas",
+  "parents": [{"id": "5fd97804dda64ee31b4541340f9ef16043232518", "displayId": "5fd97804dda"}]
+}`
+
+const forkRepositoryResponse = `{
+  "slug": "my-fork",
+  "id": 1406,
+  "name": "my-fork",
+  "scmId": "git",
+  "state": "AVAILABLE",
+  "forkable": true,
+  "origin": {
+    "slug": "repo",
+    "id": 1405,
+    "name": "repo",
+    "scmId": "git",
+    "state": "AVAILABLE",
+    "forkable": true,
+    "project": {
+      "key": "PRJ",
+      "id": 363,
+      "name": "Project 1",
+      "public": false,
+      "type": "NORMAL"
+    },
+    "public": false
+  },
+  "project": {
+    "key": "~JDOE",
+    "id": 1500,
+    "name": "John Doe",
+    "type": "PERSONAL"
+  },
+  "public": false
+}`
+
 const listProjectsRepositoriesResponse = `{
 	"size": 25,
 	"limit": 25,
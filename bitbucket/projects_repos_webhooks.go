@@ -3,6 +3,7 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 type WebhookList struct {
@@ -35,6 +36,13 @@ func (s *ProjectsService) ListWebhooks(ctx context.Context, projectKey, reposito
 	return l.Webhooks, resp, nil
 }
 
+func (s *ProjectsService) ListWebhooksIter(ctx context.Context, projectKey, repositorySlug string, opts *ListOptions) iter.Seq2[*Webhook, error] {
+	o := copyOptions(opts)
+	return paginate(ctx, o, func(ctx context.Context) ([]*Webhook, *Response, error) {
+		return s.ListWebhooks(ctx, projectKey, repositorySlug, o)
+	})
+}
+
 func (s *ProjectsService) GetWebhook(ctx context.Context, projectKey, repositorySlug string, id uint64) (*Webhook, *Response, error) {
 	p := fmt.Sprintf("projects/%s/repos/%s/webhooks/%d", projectKey, repositorySlug, id)
 	var w Webhook
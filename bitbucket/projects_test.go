@@ -2,6 +2,7 @@ package bitbucket
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -13,13 +14,31 @@ func TestListProjects(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		assert.Equal(t, "GET", req.Method)
 		assert.Equal(t, "/api/latest/projects", req.URL.Path)
+		assert.Equal(t, "25", req.URL.Query().Get("limit"))
 		rw.Write([]byte(listProjectsResponse))
 	}))
 	defer server.Close()
 
 	client, _ := NewClient(server.URL, nil)
 	ctx := context.Background()
-	repos, resp, err := client.Projects.ListProjects(ctx, &ListOptions{})
+	projects, _, err := client.Projects.ListProjects(ctx, &ListOptions{Limit: 25})
+	assert.NoError(t, err)
+	assert.Len(t, projects, 2)
+}
+
+func TestSearchProjects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects", req.URL.Path)
+		assert.Equal(t, "Project", req.URL.Query().Get("name"))
+		assert.Equal(t, "PROJECT_ADMIN", req.URL.Query().Get("permission"))
+		rw.Write([]byte(listProjectsResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	repos, resp, err := client.Projects.SearchProjects(ctx, &ProjectSearchOptions{Name: "Project", Permission: PermissionProjectAdmin})
 	assert.NoError(t, err)
 	assert.Len(t, repos, 2)
 	assert.Equal(t, uint64(363), repos[0].ID)
@@ -27,6 +46,91 @@ func TestListProjects(t *testing.T) {
 	assert.Equal(t, uint(0), resp.Page.NextPageStart)
 }
 
+func TestGetProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ1", req.URL.Path)
+		rw.Write([]byte(getProjectResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	project, _, err := client.Projects.GetProject(ctx, "PRJ1")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(363), project.ID)
+	assert.Equal(t, "PRJ1", project.Key)
+	assert.Equal(t, "My project 1", project.Description)
+	assert.Equal(t, ProjectTypeNormal, project.Type)
+}
+
+func TestCreateProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/latest/projects", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"key\":\"PRJ1\",\"name\":\"Project 1\",\"description\":\"My project 1\",\"public\":false}\n", string(b))
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(getProjectResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	project, _, err := client.Projects.CreateProject(ctx, &Project{Key: "PRJ1", Name: "Project 1", Description: "My project 1"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(363), project.ID)
+}
+
+func TestUpdateProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/api/latest/projects/OLD", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"key\":\"PRJ1\",\"name\":\"Project 1\",\"public\":true,\"avatar\":\"data:image/png;base64,iVBORw0KGgo=\"}\n", string(b))
+		rw.Write([]byte(getProjectResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	in := &ProjectUpdate{Key: Ptr("PRJ1"), Name: Ptr("Project 1"), Public: Ptr(true), Avatar: Ptr("data:image/png;base64,iVBORw0KGgo=")}
+	project, _, err := client.Projects.UpdateProject(ctx, "OLD", in)
+	assert.NoError(t, err)
+	assert.Equal(t, "PRJ1", project.Key)
+}
+
+func TestUpdateProjectDescription(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ1", req.URL.Path)
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"description\":\"New description\"}\n", string(b))
+		assert.NotContains(t, string(b), "public")
+		rw.Write([]byte(getProjectResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.UpdateProject(ctx, "PRJ1", &ProjectUpdate{Description: Ptr("New description")})
+	assert.NoError(t, err)
+}
+
+func TestDeleteProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/api/latest/projects/PRJ1", req.URL.Path)
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, err := client.Projects.DeleteProject(ctx, "PRJ1")
+	assert.NoError(t, err)
+}
+
 func TestSearchProjectPermissions(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		assert.Equal(t, "GET", req.Method)
@@ -86,6 +190,22 @@ const listProjectsResponse = `{
 }
 `
 
+const getProjectResponse = `{
+  "key": "PRJ1",
+  "id": 363,
+  "name": "Project 1",
+  "description": "My project 1",
+  "public": false,
+  "type": "NORMAL",
+  "links": {
+    "self": [
+      {
+        "href": "https://git/projects/PRJ1"
+      }
+    ]
+  }
+}`
+
 const searchProjectPermissionsResponse = `{
   "size": 9,
   "limit": 25,
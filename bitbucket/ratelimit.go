@@ -0,0 +1,148 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateFillRate  = "X-RateLimit-FillRate"
+	headerRateInterval  = "X-RateLimit-Interval-Seconds"
+	headerRetryAfter    = "Retry-After"
+)
+
+// RateLimit is the rate limit state reported by Bitbucket in the X-RateLimit-* response headers.
+// Values not reported are left as zero.
+type RateLimit struct {
+	// Limit is the maximum number of tokens in the bucket of the user.
+	Limit int
+
+	// Remaining is the number of tokens left in the bucket, only reported by some versions.
+	Remaining int
+
+	// FillRate is the number of tokens added to the bucket every Interval.
+	FillRate int
+	Interval time.Duration
+
+	// Reset is the time when requests are allowed again for a rate limited response.
+	Reset time.Time
+}
+
+// RateLimit returns the rate limit state last observed in a response.
+func (c *Client) RateLimit() RateLimit {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	return c.rate
+}
+
+func (c *Client) setRateLimit(rate RateLimit) {
+	if rate == (RateLimit{}) {
+		return
+	}
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	c.rate = rate
+}
+
+func parseRateLimit(r *http.Response) RateLimit {
+	var rate RateLimit
+	if r == nil {
+		return rate
+	}
+	rate.Limit, _ = strconv.Atoi(r.Header.Get(headerRateLimit))
+	rate.Remaining, _ = strconv.Atoi(r.Header.Get(headerRateRemaining))
+	rate.FillRate, _ = strconv.Atoi(r.Header.Get(headerRateFillRate))
+	if s, err := strconv.Atoi(r.Header.Get(headerRateInterval)); err == nil {
+		rate.Interval = time.Duration(s) * time.Second
+	}
+	if d, ok := parseRetryAfter(r.Header.Get(headerRetryAfter)); ok {
+		rate.Reset = time.Now().Add(d)
+	}
+	return rate
+}
+
+// RateLimitError is returned when Bitbucket rejects a request with 429 Too Many Requests. It wraps the
+// *ErrorResponse, use errors.As rather than a type assertion to get the *ErrorResponse of any error.
+type RateLimitError struct {
+	*ErrorResponse
+	Rate RateLimit
+}
+
+func (e *RateLimitError) Error() string {
+	if e.Rate.Reset.IsZero() {
+		return e.ErrorResponse.Error()
+	}
+	return fmt.Sprintf("%v, rate limit reset at %v", e.ErrorResponse.Error(), e.Rate.Reset.Format(time.RFC3339))
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// RateLimiter limits the rate of requests sent by the client. Wait blocks until a request is
+// allowed or the context is done. The interface is satisfied by e.g. golang.org/x/time/rate.Limiter.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// NewRateLimiter returns a token bucket RateLimiter safe for concurrent use allowing requestsPerSecond
+// on average with bursts of up to burst requests.
+func NewRateLimiter(requestsPerSecond float64, burst int) RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	// Reserve a token, possibly going into debt which later callers will have to wait for.
+	b.tokens--
+	var wait time.Duration
+	if b.tokens < 0 {
+		if b.rate <= 0 {
+			b.tokens++
+			b.mu.Unlock()
+			return fmt.Errorf("rate limiter does not allow any requests")
+		}
+		wait = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+	t := time.NewTimer(wait)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,95 @@
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("X-RateLimit-Limit", "60")
+		rw.Header().Set("X-RateLimit-Remaining", "59")
+		rw.Header().Set("X-RateLimit-FillRate", "5")
+		rw.Header().Set("X-RateLimit-Interval-Seconds", "1")
+		rw.Write([]byte(getProjectsRepositoryResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, resp, err := client.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.NoError(t, err)
+	assert.Equal(t, 60, resp.Rate.Limit)
+	assert.Equal(t, 59, resp.Rate.Remaining)
+	assert.Equal(t, 5, resp.Rate.FillRate)
+	assert.Equal(t, time.Second, resp.Rate.Interval)
+	assert.Equal(t, resp.Rate, client.RateLimit())
+}
+
+func TestRateLimitError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("X-RateLimit-Limit", "60")
+		rw.Header().Set("Retry-After", "30")
+		rw.WriteHeader(http.StatusTooManyRequests)
+		rw.Write([]byte(`{"errors":[{"message":"Rate limit exceeded"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.Error(t, err)
+	var rle *RateLimitError
+	assert.True(t, errors.As(err, &rle))
+	assert.Equal(t, 60, rle.Rate.Limit)
+	assert.WithinDuration(t, time.Now().Add(30*time.Second), rle.Rate.Reset, 5*time.Second)
+	assert.Equal(t, "Rate limit exceeded", rle.Errors[0].Message)
+	assert.True(t, IsRateLimited(err))
+	var er *ErrorResponse
+	assert.True(t, errors.As(err, &er))
+	assert.Equal(t, 60, client.RateLimit().Limit)
+}
+
+func TestRateLimiter(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		rw.Write([]byte(getProjectsRepositoryResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	client.RateLimiter = NewRateLimiter(100, 1)
+	ctx := context.Background()
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := client.Projects.GetRepository(ctx, "PRJ", "repo")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 5, calls)
+	assert.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+}
+
+func TestRateLimiterContextCancelled(t *testing.T) {
+	l := NewRateLimiter(0.001, 1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.NoError(t, l.Wait(ctx))
+	assert.ErrorIs(t, l.Wait(ctx), context.DeadlineExceeded)
+}
@@ -0,0 +1,180 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryMinBackoff  = 500 * time.Millisecond
+	defaultRetryMaxBackoff  = 30 * time.Second
+)
+
+var defaultRetryableStatus = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy defines how requests failing with transient errors are retried. Retries are
+// disabled unless a policy is set on Client.RetryPolicy. Zero values are replaced by defaults.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first request (default 3).
+	MaxAttempts int
+
+	// MinBackoff is the backoff before the first retry, doubled for every following retry (default 500ms).
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the backoff and any wait requested by a Retry-After header (default 30s).
+	MaxBackoff time.Duration
+
+	// RetryableStatus lists the response status codes to retry (default 429, 502, 503 and 504).
+	RetryableStatus []int
+
+	// RetryNonIdempotent allows retrying non-idempotent requests, i.e. requests with methods like POST
+	// and PUT requests updating versioned resources (e.g. pull requests) or committing file changes.
+	RetryNonIdempotent bool
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts <= 0 {
+		return defaultRetryMaxAttempts
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) minBackoff() time.Duration {
+	if p.MinBackoff <= 0 {
+		return defaultRetryMinBackoff
+	}
+	return p.MinBackoff
+}
+
+func (p *RetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff <= 0 {
+		return defaultRetryMaxBackoff
+	}
+	return p.MaxBackoff
+}
+
+func (p *RetryPolicy) retryableStatus(status int) bool {
+	if p.RetryableStatus == nil {
+		return slices.Contains(defaultRetryableStatus, status)
+	}
+	return slices.Contains(p.RetryableStatus, status)
+}
+
+type nonIdempotentKey struct{}
+
+// nonIdempotent marks the request as not idempotent regardless of the method, e.g. a PUT creating a
+// commit or updating a versioned resource, which fails or changes the resource again if sent after
+// succeeding. The request is thus only retried if RetryPolicy.RetryNonIdempotent is set.
+func nonIdempotent(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), nonIdempotentKey{}, true))
+}
+
+// retryable reports whether the request may be sent again, i.e. it is idempotent (or allowed
+// by the policy) and the body, if any, can be rewound.
+func (p *RetryPolicy) retryable(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	if req.Context().Value(nonIdempotentKey{}) != nil {
+		return p.RetryNonIdempotent
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return p.RetryNonIdempotent
+}
+
+// backoff returns the wait before the given retry (starting at 1) using exponential backoff with
+// jitter, or the wait requested by the Retry-After header of the response if present.
+func (p *RetryPolicy) backoff(retry int, r *http.Response) time.Duration {
+	maxWait := p.maxBackoff()
+	if r != nil {
+		if d, ok := parseRetryAfter(r.Header.Get("Retry-After")); ok {
+			return min(d, maxWait)
+		}
+	}
+
+	d := p.minBackoff() << (retry - 1)
+	if d <= 0 || d > maxWait {
+		d = maxWait
+	}
+	// Full jitter in the upper half of the interval to spread out concurrent clients.
+	return d/2 + rand.N(d/2+1)
+}
+
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil {
+		return max(time.Duration(s)*time.Second, 0), true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+// send sends the request retrying transient failures according to the retry policy of the client.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	p := c.RetryPolicy
+	if p == nil || !p.retryable(req) {
+		return c.roundTrip(ctx, req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("unable to rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+
+		r, err := c.roundTrip(ctx, req)
+		if attempt >= p.maxAttempts() || ctx.Err() != nil {
+			return r, err
+		}
+		if err == nil && !p.retryableStatus(r.StatusCode) {
+			return r, nil
+		}
+
+		wait := p.backoff(attempt, r)
+		if r != nil {
+			// Drain the body to allow the connection to be reused.
+			_, _ = io.Copy(io.Discard, io.LimitReader(r.Body, maxErrorBodySize))
+			r.Body.Close()
+		}
+
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// roundTrip sends the request once, waiting for the rate limiter of the client if set.
+func (c *Client) roundTrip(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.RateLimiter != nil {
+		if err := c.RateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return c.client.Do(req.WithContext(ctx))
+}
@@ -0,0 +1,166 @@
+package bitbucket
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryTransientStatus(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+		if calls < 3 {
+			rw.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		rw.Write([]byte(getProjectsRepositoryResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	client.RetryPolicy = &RetryPolicy{MinBackoff: time.Millisecond}
+	ctx := context.Background()
+	repo, _, err := client.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.NoError(t, err)
+	assert.Equal(t, "repo", repo.Slug)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryExhausted(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}
+	ctx := context.Background()
+	_, resp, err := client.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 2, calls)
+}
+
+func TestRetryDisabled(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+		rw.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	ctx := context.Background()
+	_, _, err := client.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "{\"scmId\":\"git\",\"name\":\"go-bitbucket-demo\"}\n", string(b))
+		if calls == 1 {
+			rw.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		rw.Write([]byte(createProjectRepositoryResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	client.RetryPolicy = &RetryPolicy{MinBackoff: time.Millisecond}
+	ctx := context.Background()
+	in := &Repository{Name: "go-bitbucket-demo", ScmID: "git"}
+	_, _, err := client.Projects.CreateRepository(ctx, "PRJ", in)
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+
+	client.RetryPolicy.RetryNonIdempotent = true
+	calls = 0
+	repo, _, err := client.Projects.CreateRepository(ctx, "PRJ", in)
+	assert.NoError(t, err)
+	assert.Equal(t, "go-bitbucket-demo", repo.Slug)
+	assert.Equal(t, 2, calls)
+}
+
+func TestRetryVersionedUpdate(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+		rw.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	client.RetryPolicy = &RetryPolicy{MinBackoff: time.Millisecond}
+	ctx := context.Background()
+	_, _, err := client.Projects.UpdatePullRequest(ctx, "PRJ", "repo", 1, &PullRequestUpdate{Version: 2, Title: Ptr("New title")})
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+
+	calls = 0
+	_, _, err = client.Projects.UpdatePullRequestComment(ctx, "PRJ", "repo", 1, 3, &CommentUpdate{Version: 0, Text: Ptr("Edited")})
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetryAfter(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+		if calls == 1 {
+			rw.Header().Set("Retry-After", "1")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		rw.Write([]byte(getProjectsRepositoryResponse))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	client.RetryPolicy = &RetryPolicy{MinBackoff: time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+	ctx := context.Background()
+	start := time.Now()
+	_, _, err := client.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestRetryContextCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, nil)
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 5, MinBackoff: time.Minute}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, _, err := client.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	d, ok := parseRetryAfter("120")
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, d)
+
+	d, ok = parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Greater(t, d, 59*time.Minute)
+
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+}
@@ -14,6 +14,17 @@ var (
 	DeleteAccessTokenUser = EndpointPattern{Pattern: "/access-tokens/latest/users/:userSlug/:tokenId", Method: "DELETE"}
 )
 
+var (
+	ListRestrictionsProject     = EndpointPattern{Pattern: "/branch-permissions/latest/projects/:projectKey/restrictions", Method: "GET"}
+	GetRestrictionProject       = EndpointPattern{Pattern: "/branch-permissions/latest/projects/:projectKey/restrictions/:id", Method: "GET"}
+	CreateRestrictionProject    = EndpointPattern{Pattern: "/branch-permissions/latest/projects/:projectKey/restrictions", Method: "POST"}
+	DeleteRestrictionProject    = EndpointPattern{Pattern: "/branch-permissions/latest/projects/:projectKey/restrictions/:id", Method: "DELETE"}
+	ListRestrictionsRepository  = EndpointPattern{Pattern: "/branch-permissions/latest/projects/:projectKey/repos/:repositorySlug/restrictions", Method: "GET"}
+	GetRestrictionRepository    = EndpointPattern{Pattern: "/branch-permissions/latest/projects/:projectKey/repos/:repositorySlug/restrictions/:id", Method: "GET"}
+	CreateRestrictionRepository = EndpointPattern{Pattern: "/branch-permissions/latest/projects/:projectKey/repos/:repositorySlug/restrictions", Method: "POST"}
+	DeleteRestrictionRepository = EndpointPattern{Pattern: "/branch-permissions/latest/projects/:projectKey/repos/:repositorySlug/restrictions/:id", Method: "DELETE"}
+)
+
 var (
 	ListKeysRepository  = EndpointPattern{Pattern: "/keys/latest/projects/:projectKey/repos/:repositorySlug/ssh", Method: "GET"}
 	GetKeyRepository    = EndpointPattern{Pattern: "/keys/latest/projects/:projectKey/repos/:repositorySlug/ssh/:keyId", Method: "GET"}
@@ -22,23 +33,90 @@ var (
 )
 
 var (
-	ListProjects             = EndpointPattern{Pattern: "/api/latest/projects", Method: "GET"}
-	SearchProjectPermissions = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/permissions/search", Method: "GET"}
-	SearchRepositories       = EndpointPattern{Pattern: "/api/latest/repos", Method: "GET"}
-	ListRepositories         = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos", Method: "GET"}
-	GetRepository            = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug", Method: "GET"}
-	CreateRepository         = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos", Method: "POST"}
-	DeleteRepository         = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug", Method: "DELETE"}
-	SearchBranches           = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/branches", Method: "GET"}
-	GetDefaultBranch         = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/branches/default", Method: "GET"}
-	SearchCommits            = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/commits", Method: "GET"}
-	GetCommit                = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/commits/:commitId", Method: "GET"}
-	SearchPullRequests       = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests", Method: "GET"}
-	GetPullRequest           = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId", Method: "GET"}
-	ListWebhooks             = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/webhooks", Method: "GET"}
-	GetWebhook               = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/webhooks/:id", Method: "GET"}
-	CreateWebhook            = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/webhooks", Method: "POST"}
-	DeleteWebhook            = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/webhooks/:id", Method: "DELETE"}
+	ListProjects                    = EndpointPattern{Pattern: "/api/latest/projects", Method: "GET"}
+	GetProject                      = EndpointPattern{Pattern: "/api/latest/projects/:projectKey", Method: "GET"}
+	CreateProject                   = EndpointPattern{Pattern: "/api/latest/projects", Method: "POST"}
+	UpdateProject                   = EndpointPattern{Pattern: "/api/latest/projects/:projectKey", Method: "PUT"}
+	DeleteProject                   = EndpointPattern{Pattern: "/api/latest/projects/:projectKey", Method: "DELETE"}
+	SearchProjectPermissions        = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/permissions/search", Method: "GET"}
+	ListProjectUserPermissions      = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/permissions/users", Method: "GET"}
+	GrantProjectUserPermission      = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/permissions/users", Method: "PUT"}
+	RevokeProjectUserPermission     = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/permissions/users", Method: "DELETE"}
+	ListProjectGroupPermissions     = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/permissions/groups", Method: "GET"}
+	GrantProjectGroupPermission     = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/permissions/groups", Method: "PUT"}
+	RevokeProjectGroupPermission    = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/permissions/groups", Method: "DELETE"}
+	GetProjectDefaultPermission     = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/permissions/:permission/all", Method: "GET"}
+	SetProjectDefaultPermission     = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/permissions/:permission/all", Method: "POST"}
+	SearchRepositories              = EndpointPattern{Pattern: "/api/latest/repos", Method: "GET"}
+	ListRepositories                = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos", Method: "GET"}
+	GetRepository                   = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug", Method: "GET"}
+	CreateRepository                = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos", Method: "POST"}
+	ForkRepository                  = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug", Method: "POST"}
+	ListForks                       = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/forks", Method: "GET"}
+	ListRelatedRepositories         = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/related", Method: "GET"}
+	DeleteRepository                = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug", Method: "DELETE"}
+	SearchRepositoryPermissions     = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/permissions/search", Method: "GET"}
+	ListRepositoryUserPermissions   = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/permissions/users", Method: "GET"}
+	GrantRepositoryUserPermission   = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/permissions/users", Method: "PUT"}
+	RevokeRepositoryUserPermission  = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/permissions/users", Method: "DELETE"}
+	ListRepositoryGroupPermissions  = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/permissions/groups", Method: "GET"}
+	GrantRepositoryGroupPermission  = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/permissions/groups", Method: "PUT"}
+	RevokeRepositoryGroupPermission = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/permissions/groups", Method: "DELETE"}
+	UpdateRepository                = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug", Method: "PUT"}
+	SearchBranches                  = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/branches", Method: "GET"}
+	CreateBranch                    = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/branches", Method: "POST"}
+	SetDefaultBranch                = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/branches/default", Method: "PUT"}
+	GetDefaultBranch                = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/branches/default", Method: "GET"}
+	SearchTags                      = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/tags", Method: "GET"}
+	GetTag                          = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/tags/:tagName", Method: "GET"}
+	CreateTag                       = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/tags", Method: "POST"}
+	SearchCommits                   = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/commits", Method: "GET"}
+	GetCommit                       = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/commits/:commitId", Method: "GET"}
+	GetCommitDiff                   = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/commits/:commitId/diff", Method: "GET"}
+	CompareDiff                     = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/compare/diff", Method: "GET"}
+	GetRawFile                      = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/raw/*path", Method: "GET"}
+	EditFile                        = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/browse/*path", Method: "PUT"}
+	SearchPullRequests              = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests", Method: "GET"}
+	GetPullRequest                  = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId", Method: "GET"}
+	CreatePullRequest               = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests", Method: "POST"}
+	UpdatePullRequest               = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId", Method: "PUT"}
+	DeletePullRequest               = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId", Method: "DELETE"}
+	CanMergePullRequest             = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/merge", Method: "GET"}
+	MergePullRequest                = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/merge", Method: "POST"}
+	DeclinePullRequest              = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/decline", Method: "POST"}
+	ReopenPullRequest               = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/reopen", Method: "POST"}
+	ListPullRequestCommits          = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/commits", Method: "GET"}
+	GetPullRequestDiff              = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/diff", Method: "GET"}
+	ListPullRequestActivities       = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/activities", Method: "GET"}
+	ListPullRequestComments         = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/comments", Method: "GET"}
+	ListPullRequestBlockerComments  = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/blocker-comments", Method: "GET"}
+	GetPullRequestComment           = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/comments/:commentId", Method: "GET"}
+	CreatePullRequestComment        = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/comments", Method: "POST"}
+	UpdatePullRequestComment        = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/comments/:commentId", Method: "PUT"}
+	DeletePullRequestComment        = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/comments/:commentId", Method: "DELETE"}
+	ListPullRequestParticipants     = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/participants", Method: "GET"}
+	AddPullRequestReviewer          = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/participants", Method: "POST"}
+	SetPullRequestParticipantStatus = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/participants/:userSlug", Method: "PUT"}
+	RemovePullRequestReviewer       = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/pull-requests/:pullRequestId/participants/:userSlug", Method: "DELETE"}
+	ListWebhooks                    = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/webhooks", Method: "GET"}
+	GetWebhook                      = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/webhooks/:id", Method: "GET"}
+	CreateWebhook                   = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/webhooks", Method: "POST"}
+	DeleteWebhook                   = EndpointPattern{Pattern: "/api/latest/projects/:projectKey/repos/:repositorySlug/webhooks/:id", Method: "DELETE"}
+)
+
+var (
+	GetProjectBranchModelConfiguration       = EndpointPattern{Pattern: "/branch-utils/latest/projects/:projectKey/branchmodel/configuration", Method: "GET"}
+	UpdateProjectBranchModelConfiguration    = EndpointPattern{Pattern: "/branch-utils/latest/projects/:projectKey/branchmodel/configuration", Method: "PUT"}
+	DeleteProjectBranchModelConfiguration    = EndpointPattern{Pattern: "/branch-utils/latest/projects/:projectKey/branchmodel/configuration", Method: "DELETE"}
+	GetBranchModel                           = EndpointPattern{Pattern: "/branch-utils/latest/projects/:projectKey/repos/:repositorySlug/branchmodel", Method: "GET"}
+	GetRepositoryBranchModelConfiguration    = EndpointPattern{Pattern: "/branch-utils/latest/projects/:projectKey/repos/:repositorySlug/branchmodel/configuration", Method: "GET"}
+	UpdateRepositoryBranchModelConfiguration = EndpointPattern{Pattern: "/branch-utils/latest/projects/:projectKey/repos/:repositorySlug/branchmodel/configuration", Method: "PUT"}
+	DeleteRepositoryBranchModelConfiguration = EndpointPattern{Pattern: "/branch-utils/latest/projects/:projectKey/repos/:repositorySlug/branchmodel/configuration", Method: "DELETE"}
+	DeleteBranch                             = EndpointPattern{Pattern: "/branch-utils/latest/projects/:projectKey/repos/:repositorySlug/branches", Method: "DELETE"}
+)
+
+var (
+	DeleteTag = EndpointPattern{Pattern: "/git/latest/projects/:projectKey/repos/:repositorySlug/tags/:tagName", Method: "DELETE"}
 )
 
 var (
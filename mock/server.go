@@ -5,23 +5,40 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"sync"
 
-	"github.com/julienschmidt/httprouter"
 	"github.com/neticdk/go-bitbucket/bitbucket"
 )
 
-type MockBackendOption func(*httprouter.Router)
+type MockBackendOption func(*http.ServeMux)
+
+// allowedMethods are the methods checked to tell a request with a method not allowed from an unknown path.
+var allowedMethods = []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
 
 func NewMockServer(opts ...MockBackendOption) *httptest.Server {
-	router := httprouter.New()
-	router.NotFound = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		WriteError(w, http.StatusNotFound, []bitbucket.ErrorMessage{{Message: "Not Found"}})
-	})
+	mux := http.NewServeMux()
 	for _, o := range opts {
-		o(router)
+		o(mux)
 	}
-	mockServer := httptest.NewServer(router)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		var allowed []string
+		for _, m := range allowedMethods {
+			mr := *r
+			mr.Method = m
+			if _, pattern := mux.Handler(&mr); pattern != "/" {
+				allowed = append(allowed, m)
+			}
+		}
+		if len(allowed) > 0 {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		WriteError(w, http.StatusNotFound, []bitbucket.ErrorMessage{{Message: "Not Found"}})
+	})
+	mockServer := httptest.NewServer(mux)
 	return mockServer
 }
 
@@ -41,9 +58,24 @@ type EndpointPattern struct {
 }
 
 func WithRequestMatchHandler(ep EndpointPattern, handler http.Handler) MockBackendOption {
-	return func(router *httprouter.Router) {
-		router.Handler(ep.Method, ep.Pattern, handler)
+	return func(mux *http.ServeMux) {
+		mux.Handle(ep.Method+" "+muxPattern(ep.Pattern), handler)
+	}
+}
+
+// muxPattern converts the wildcards of the pattern, :name and *name, to the {name} and {name...} wildcards
+// of http.ServeMux.
+func muxPattern(pattern string) string {
+	segments := strings.Split(pattern, "/")
+	for i, sg := range segments {
+		switch {
+		case strings.HasPrefix(sg, ":"):
+			segments[i] = "{" + sg[1:] + "}"
+		case strings.HasPrefix(sg, "*"):
+			segments[i] = "{" + sg[1:] + "...}"
+		}
 	}
+	return strings.Join(segments, "/")
 }
 
 func WithRequestMatch(ep EndpointPattern, responsesFIFO ...interface{}) MockBackendOption {
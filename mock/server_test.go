@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/neticdk/go-bitbucket/bitbucket"
@@ -57,3 +58,92 @@ func TestNewMockServer(t *testing.T) {
 	assert.NotNil(t, repo)
 	assert.Len(t, repo.Links["clone"], 2)
 }
+
+func TestNewMockServerNotFound(t *testing.T) {
+	mockServer := NewMockServer()
+
+	ctx := context.Background()
+	c, _ := bitbucket.NewClient(mockServer.URL, nil)
+
+	_, _, err := c.Projects.GetRepository(ctx, "prj", "repo")
+	assert.Error(t, err)
+	er, ok := err.(*bitbucket.ErrorResponse)
+	assert.True(t, ok)
+	assert.Equal(t, []bitbucket.ErrorMessage{{Message: "Not Found"}}, er.Errors)
+}
+
+func TestNewMockServerProjects(t *testing.T) {
+	mockServer := NewMockServer(
+		WithRequestMatch(ListProjects, bitbucket.ProjectList{Projects: []*bitbucket.Project{{Key: "PRJ"}}}),
+		WithRequestMatch(GetProject, bitbucket.Project{Key: "PRJ", Name: "Project"}),
+		WithRequestMatch(CreateProject, bitbucket.Project{Key: "NEW", Name: "New"}),
+		WithRequestMatch(UpdateProject, bitbucket.Project{Key: "PRJ", Name: "Updated"}),
+		WithRequestMatchHandler(DeleteProject, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})),
+		WithRequestMatch(ListRepositories, bitbucket.RepositoryList{Repositories: []*bitbucket.Repository{{Slug: "repo"}}}),
+	)
+
+	ctx := context.Background()
+	c, _ := bitbucket.NewClient(mockServer.URL, nil)
+
+	projects, _, err := c.Projects.ListProjects(ctx, nil)
+	assert.NoError(t, err)
+	assert.Len(t, projects, 1)
+	project, _, err := c.Projects.GetProject(ctx, "PRJ")
+	assert.NoError(t, err)
+	assert.Equal(t, "Project", project.Name)
+	project, _, err = c.Projects.CreateProject(ctx, &bitbucket.Project{Key: "NEW", Name: "New"})
+	assert.NoError(t, err)
+	assert.Equal(t, "NEW", project.Key)
+	project, _, err = c.Projects.UpdateProject(ctx, "PRJ", &bitbucket.ProjectUpdate{Name: bitbucket.Ptr("Updated")})
+	assert.NoError(t, err)
+	assert.Equal(t, "Updated", project.Name)
+	_, err = c.Projects.DeleteProject(ctx, "PRJ")
+	assert.NoError(t, err)
+	repos, _, err := c.Projects.ListRepositories(ctx, "PRJ", nil)
+	assert.NoError(t, err)
+	assert.Len(t, repos, 1)
+}
+
+func TestNewMockServerConflictingPatterns(t *testing.T) {
+	mockServer := NewMockServer(
+		WithRequestMatch(ListProjectUserPermissions, bitbucket.UserPermissionList{}),
+		WithRequestMatch(GetProjectDefaultPermission, bitbucket.DefaultPermission{Permitted: true}),
+		WithRequestMatchHandler(SetProjectDefaultPermission, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "true", r.URL.Query().Get("allow"))
+			w.WriteHeader(http.StatusNoContent)
+		})),
+	)
+
+	ctx := context.Background()
+	c, _ := bitbucket.NewClient(mockServer.URL, nil)
+
+	_, _, err := c.Projects.ListProjectUserPermissions(ctx, "PRJ", nil)
+	assert.NoError(t, err)
+	permitted, _, err := c.Projects.GetProjectDefaultPermission(ctx, "PRJ", bitbucket.PermissionProjectRead)
+	assert.NoError(t, err)
+	assert.True(t, permitted)
+	_, err = c.Projects.SetProjectDefaultPermission(ctx, "PRJ", bitbucket.PermissionProjectRead, true)
+	assert.NoError(t, err)
+	_, _, err = c.Projects.GetRepository(ctx, "PRJ", "repo")
+	assert.True(t, bitbucket.IsNotFound(err))
+}
+
+func TestNewMockServerMethodNotAllowed(t *testing.T) {
+	mockServer := NewMockServer(
+		WithRequestMatch(GetProjectDefaultPermission, bitbucket.DefaultPermission{Permitted: true}),
+	)
+	defer mockServer.Close()
+
+	resp, err := http.Post(mockServer.URL+"/api/latest/projects/PRJ/permissions/PROJECT_READ/all", "", nil)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Allow"), "GET")
+
+	resp, err = http.Get(mockServer.URL + "/api/latest/projects/PRJ/permissions/users")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
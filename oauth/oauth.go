@@ -0,0 +1,325 @@
+// Package oauth implements the OAuth 2.0 client credentials and authorization code (with PKCE) flows
+// against the incoming application links of Bitbucket Data Center. Tokens are supplied to the
+// bitbucket client through bitbucket.BearerTokenTransport.
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/neticdk/go-bitbucket/bitbucket"
+)
+
+const (
+	authorizePath = "rest/oauth2/latest/authorize"
+	tokenPath     = "rest/oauth2/latest/token"
+
+	// expiryDelta is subtracted from the token expiry to avoid using tokens about to expire.
+	expiryDelta = 10 * time.Second
+
+	maxTokenResponseSize = 1024 * 1024 // 1 MiB
+)
+
+// Scope is an OAuth 2.0 scope supported by Bitbucket.
+type Scope string
+
+const (
+	ScopePublicRepos  Scope = "PUBLIC_REPOS"
+	ScopeAccountWrite Scope = "ACCOUNT_WRITE"
+	ScopeRepoRead     Scope = "REPO_READ"
+	ScopeRepoWrite    Scope = "REPO_WRITE"
+	ScopeRepoAdmin    Scope = "REPO_ADMIN"
+	ScopeProjectAdmin Scope = "PROJECT_ADMIN"
+	ScopeAdminWrite   Scope = "ADMIN_WRITE"
+	ScopeSystemAdmin  Scope = "SYSTEM_ADMIN"
+)
+
+// Config describes an OAuth 2.0 incoming application link configured in Bitbucket.
+type Config struct {
+	// BaseURL is the base URL of Bitbucket, i.e. the same URL as passed to bitbucket.NewClient.
+	BaseURL string
+
+	ClientID     string
+	ClientSecret string
+
+	// RedirectURL is the redirect URL registered for the application link, used by the authorization code flow.
+	RedirectURL string
+
+	Scopes []Scope
+
+	// HTTPClient is used to call the token endpoint, http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+// Token is an OAuth 2.0 token issued by Bitbucket.
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	TokenType    string    `json:"token_type,omitempty"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Valid reports whether the token is set and not about to expire.
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Now().Add(expiryDelta).Before(t.Expiry)
+}
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+	Scope        string `json:"scope"`
+}
+
+// RetrieveError is returned when the token endpoint rejects a token request.
+type RetrieveError struct {
+	Response         *http.Response
+	Body             []byte
+	ErrorCode        string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (e *RetrieveError) Error() string {
+	if e.ErrorCode != "" {
+		s := fmt.Sprintf("oauth: token request failed: %d %s", e.Response.StatusCode, e.ErrorCode)
+		if e.ErrorDescription != "" {
+			s += ": " + e.ErrorDescription
+		}
+		return s
+	}
+	return fmt.Sprintf("oauth: token request failed: %d %s", e.Response.StatusCode, e.Body)
+}
+
+// GenerateVerifier returns a random PKCE code verifier for the authorization code flow.
+func GenerateVerifier() string {
+	b := make([]byte, 32)
+	// rand.Read never returns an error and always fills b entirely.
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// challenge returns the S256 PKCE code challenge for the verifier.
+func challenge(verifier string) string {
+	h := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
+
+func (c *Config) endpoint(path string) (string, error) {
+	base, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasSuffix(base.Path, "/") {
+		base.Path += "/"
+	}
+	u, err := base.Parse(path)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func (c *Config) scope() string {
+	s := make([]string, len(c.Scopes))
+	for i, sc := range c.Scopes {
+		s[i] = string(sc)
+	}
+	return strings.Join(s, " ")
+}
+
+// AuthCodeURL returns the URL to redirect the user to for consent. The state protects against
+// CSRF and the verifier, see GenerateVerifier, must be passed to Exchange with the returned code.
+func (c *Config) AuthCodeURL(state, verifier string) (string, error) {
+	u, err := c.endpoint(authorizePath)
+	if err != nil {
+		return "", err
+	}
+	v := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {c.ClientID},
+		"state":                 {state},
+		"code_challenge":        {challenge(verifier)},
+		"code_challenge_method": {"S256"},
+	}
+	if c.RedirectURL != "" {
+		v.Set("redirect_uri", c.RedirectURL)
+	}
+	if len(c.Scopes) > 0 {
+		v.Set("scope", c.scope())
+	}
+	return u + "?" + v.Encode(), nil
+}
+
+// Exchange converts an authorization code into a token using the PKCE verifier used for AuthCodeURL.
+func (c *Config) Exchange(ctx context.Context, code, verifier string) (*Token, error) {
+	v := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"code_verifier": {verifier},
+	}
+	if c.RedirectURL != "" {
+		v.Set("redirect_uri", c.RedirectURL)
+	}
+	return c.retrieveToken(ctx, v)
+}
+
+// ClientCredentialsToken requests a token using the client credentials grant.
+func (c *Config) ClientCredentialsToken(ctx context.Context) (*Token, error) {
+	v := url.Values{
+		"grant_type": {"client_credentials"},
+	}
+	if len(c.Scopes) > 0 {
+		v.Set("scope", c.scope())
+	}
+	return c.retrieveToken(ctx, v)
+}
+
+// Refresh requests a new token using the refresh token.
+func (c *Config) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	v := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	}
+	return c.retrieveToken(ctx, v)
+}
+
+func (c *Config) retrieveToken(ctx context.Context, v url.Values) (*Token, error) {
+	u, err := c.endpoint(tokenPath)
+	if err != nil {
+		return nil, err
+	}
+	v.Set("client_id", c.ClientID)
+	if c.ClientSecret != "" {
+		v.Set("client_secret", c.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", u, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	r, err := hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("oauth: unable to request token: %w", err)
+	}
+	defer r.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxTokenResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("oauth: unable to read token response: %w", err)
+	}
+	if r.StatusCode < 200 || r.StatusCode > 299 {
+		e := &RetrieveError{Response: r, Body: body}
+		_ = json.Unmarshal(body, e)
+		return nil, e
+	}
+
+	var tr tokenResponse
+	if err := json.Unmarshal(body, &tr); err != nil {
+		return nil, fmt.Errorf("oauth: unable to parse token response: %w", err)
+	}
+	if tr.AccessToken == "" {
+		return nil, fmt.Errorf("oauth: token response contains no access token")
+	}
+	t := &Token{
+		AccessToken:  tr.AccessToken,
+		TokenType:    tr.TokenType,
+		RefreshToken: tr.RefreshToken,
+	}
+	if tr.ExpiresIn > 0 {
+		t.Expiry = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+	}
+	return t, nil
+}
+
+// TokenSource caches a token and retrieves a new one when it expires. It implements
+// bitbucket.TokenSource and is safe for concurrent use.
+type TokenSource struct {
+	ctx   context.Context
+	mu    sync.Mutex
+	token *Token
+	fetch func(ctx context.Context, t *Token) (*Token, error)
+}
+
+// ClientCredentialsTokenSource returns a TokenSource requesting tokens using the client credentials grant.
+// The context is used for token requests.
+func (c *Config) ClientCredentialsTokenSource(ctx context.Context) *TokenSource {
+	return &TokenSource{
+		ctx: ctx,
+		fetch: func(ctx context.Context, _ *Token) (*Token, error) {
+			return c.ClientCredentialsToken(ctx)
+		},
+	}
+}
+
+// TokenSource returns a TokenSource starting from the token, e.g. obtained through Exchange,
+// and refreshing it using the refresh token when it expires. The context is used for token requests.
+func (c *Config) TokenSource(ctx context.Context, t *Token) *TokenSource {
+	return &TokenSource{
+		ctx:   ctx,
+		token: t,
+		fetch: func(ctx context.Context, t *Token) (*Token, error) {
+			if t == nil || t.RefreshToken == "" {
+				return nil, fmt.Errorf("oauth: token expired and refresh token is not set")
+			}
+			nt, err := c.Refresh(ctx, t.RefreshToken)
+			if err != nil {
+				return nil, err
+			}
+			if nt.RefreshToken == "" {
+				nt.RefreshToken = t.RefreshToken
+			}
+			return nt, nil
+		},
+	}
+}
+
+// Current returns the current token, retrieving a new one if it is missing or expired.
+func (s *TokenSource) Current() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token.Valid() {
+		return s.token, nil
+	}
+	t, err := s.fetch(s.ctx, s.token)
+	if err != nil {
+		return nil, err
+	}
+	s.token = t
+	return t, nil
+}
+
+// Token implements bitbucket.TokenSource.
+func (s *TokenSource) Token() (string, error) {
+	t, err := s.Current()
+	if err != nil {
+		return "", err
+	}
+	return t.AccessToken, nil
+}
+
+// Client returns an *http.Client authenticating requests using tokens from the source. The client
+// is passed to bitbucket.NewClient.
+func (s *TokenSource) Client() *http.Client {
+	return (&bitbucket.BearerTokenTransport{Source: s}).Client()
+}
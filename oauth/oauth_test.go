@@ -0,0 +1,133 @@
+package oauth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/neticdk/go-bitbucket/bitbucket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCredentials(t *testing.T) {
+	tokenCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/bitbucket/rest/oauth2/latest/token":
+			tokenCalls++
+			assert.Equal(t, "POST", req.Method)
+			assert.NoError(t, req.ParseForm())
+			assert.Equal(t, "client_credentials", req.PostForm.Get("grant_type"))
+			assert.Equal(t, "id", req.PostForm.Get("client_id"))
+			assert.Equal(t, "secret", req.PostForm.Get("client_secret"))
+			assert.Equal(t, "REPO_READ PROJECT_ADMIN", req.PostForm.Get("scope"))
+			rw.Header().Set("Content-Type", "application/json")
+			rw.Write([]byte(`{"access_token":"token-1","token_type":"bearer","expires_in":3600}`))
+		case "/bitbucket/api/latest/users/user":
+			assert.Equal(t, "Bearer token-1", req.Header.Get("Authorization"))
+			rw.Write([]byte(`{"name":"user","slug":"user"}`))
+		default:
+			t.Errorf("unexpected request: %s", req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	cfg := &Config{
+		BaseURL:      server.URL + "/bitbucket",
+		ClientID:     "id",
+		ClientSecret: "secret",
+		Scopes:       []Scope{ScopeRepoRead, ScopeProjectAdmin},
+	}
+	client, _ := bitbucket.NewClient(server.URL+"/bitbucket", cfg.ClientCredentialsTokenSource(ctx).Client())
+	for range 2 {
+		u, _, err := client.Users.GetUser(ctx, "user")
+		assert.NoError(t, err)
+		assert.Equal(t, "user", u.Slug)
+	}
+	assert.Equal(t, 1, tokenCalls)
+}
+
+func TestAuthorizationCode(t *testing.T) {
+	verifier := GenerateVerifier()
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/rest/oauth2/latest/token", req.URL.Path)
+		assert.NoError(t, req.ParseForm())
+		switch req.PostForm.Get("grant_type") {
+		case "authorization_code":
+			assert.Equal(t, "code", req.PostForm.Get("code"))
+			assert.Equal(t, verifier, req.PostForm.Get("code_verifier"))
+			assert.Equal(t, "https://app/callback", req.PostForm.Get("redirect_uri"))
+			rw.Write([]byte(`{"access_token":"token-1","token_type":"bearer","refresh_token":"refresh-1","expires_in":1}`))
+		case "refresh_token":
+			assert.Equal(t, "refresh-1", req.PostForm.Get("refresh_token"))
+			rw.Write([]byte(`{"access_token":"token-2","token_type":"bearer","expires_in":3600}`))
+		default:
+			t.Errorf("unexpected grant type: %s", req.PostForm.Get("grant_type"))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	cfg := &Config{
+		BaseURL:     server.URL,
+		ClientID:    "id",
+		RedirectURL: "https://app/callback",
+		Scopes:      []Scope{ScopeRepoWrite},
+	}
+
+	authURL, err := cfg.AuthCodeURL("state", verifier)
+	assert.NoError(t, err)
+	u, _ := url.Parse(authURL)
+	assert.Equal(t, "/rest/oauth2/latest/authorize", u.Path)
+	assert.Equal(t, "code", u.Query().Get("response_type"))
+	assert.Equal(t, "state", u.Query().Get("state"))
+	assert.Equal(t, "S256", u.Query().Get("code_challenge_method"))
+	assert.Equal(t, challenge(verifier), u.Query().Get("code_challenge"))
+	assert.Equal(t, "REPO_WRITE", u.Query().Get("scope"))
+
+	token, err := cfg.Exchange(ctx, "code", verifier)
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", token.AccessToken)
+	assert.WithinDuration(t, time.Now().Add(time.Second), token.Expiry, time.Second)
+
+	// The token expires within the expiry delta and is refreshed immediately.
+	src := cfg.TokenSource(ctx, token)
+	access, err := src.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "token-2", access)
+	current, _ := src.Current()
+	assert.Equal(t, "refresh-1", current.RefreshToken)
+}
+
+func TestChallenge(t *testing.T) {
+	// Example from RFC 7636 appendix B.
+	assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", challenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+	assert.NotEqual(t, GenerateVerifier(), GenerateVerifier())
+}
+
+func TestRetrieveError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusBadRequest)
+		rw.Write([]byte(`{"error":"invalid_client","error_description":"Client authentication failed"}`))
+	}))
+	defer server.Close()
+
+	cfg := &Config{BaseURL: server.URL, ClientID: "id", ClientSecret: "wrong"}
+	_, err := cfg.ClientCredentialsTokenSource(context.Background()).Token()
+	assert.Error(t, err)
+	re, ok := err.(*RetrieveError)
+	assert.True(t, ok)
+	assert.Equal(t, "invalid_client", re.ErrorCode)
+	assert.Equal(t, "Client authentication failed", re.ErrorDescription)
+}
+
+func TestTokenSourceWithoutRefreshToken(t *testing.T) {
+	cfg := &Config{BaseURL: "http://localhost", ClientID: "id"}
+	src := cfg.TokenSource(context.Background(), &Token{AccessToken: "expired", Expiry: time.Now().Add(-time.Minute)})
+	_, err := src.Token()
+	assert.Error(t, err)
+}